@@ -134,6 +134,7 @@ import (
 	// Force-load the tracer engines to trigger registration due to Go-Ethereum v1.10.15 changes
 	_ "github.com/ethereum/go-ethereum/eth/tracers/js"
 	_ "github.com/ethereum/go-ethereum/eth/tracers/native"
+	evmtracers "github.com/evmos/ethermint/x/evm/tracers"
 )
 
 func init() {
@@ -143,6 +144,9 @@ func init() {
 	}
 
 	DefaultNodeHome = filepath.Join(userHomeDir, ".ethermintd")
+
+	// register the app specific native tracers
+	evmtypes.RegisterTracer(evmtracers.PrecompileCallTracerName, evmtracers.NewPrecompileCallTracer)
 }
 
 const appName = "ethermintd"
@@ -428,6 +432,8 @@ func NewEthermintApp(
 		app.AccountKeeper, app.BankKeeper, stakingKeeper, app.FeeMarketKeeper,
 		vm.NewEVM, tracer, evmSs,
 	)
+	app.EvmKeeper.SetMaxTraceWorkers(cast.ToInt(appOpts.Get(srvflags.EVMMaxTraceWorkers)))
+	app.EvmKeeper.SetQueryContextCreator(app.CreateQueryContext)
 
 	// Create IBC Keeper
 	app.IBCKeeper = ibckeeper.NewKeeper(
@@ -0,0 +1,144 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package indexer
+
+import (
+	"fmt"
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+	dbm "github.com/cometbft/cometbft-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	ethermint "github.com/evmos/ethermint/types"
+)
+
+const (
+	KeyPrefixAddressTx = 7
+
+	// AddressTxKeyLength is the length of address-tx key
+	AddressTxKeyLength = 1 + common.AddressLength + 8 + 8
+)
+
+// saveAddressTxs index the tx hash under the sender, the recipient and the created
+// contract of the eth tx into the kv db batch.
+func saveAddressTxs(batch dbm.Batch, tx *ethtypes.Transaction, from common.Address, txResult *ethermint.TxResult) error {
+	addresses := []common.Address{from}
+	if to := tx.To(); to != nil {
+		addresses = append(addresses, *to)
+	} else {
+		addresses = append(addresses, crypto.CreateAddress(from, tx.Nonce()))
+	}
+
+	for i, address := range addresses {
+		if i > 0 && address == addresses[0] {
+			// self transfer
+			continue
+		}
+		if err := batch.Set(AddressTxKey(address, txResult.Height, txResult.EthTxIndex), tx.Hash().Bytes()); err != nil {
+			return errorsmod.Wrap(err, "set address-tx key")
+		}
+	}
+	return nil
+}
+
+// IndexInternalTxs indexes the hashes of the already indexed eth txs under the addresses
+// taking part in their internal transactions, eg. the contracts created by a factory.
+func (kv *KVIndexer) IndexInternalTxs(addresses map[common.Hash][]common.Address) error {
+	batch := kv.db.NewBatch()
+	defer batch.Close()
+
+	for hash, txAddresses := range addresses {
+		// the txs which failed before their execution aren't indexed
+		indexed, err := kv.db.Has(TxHashKey(hash))
+		if err != nil {
+			return errorsmod.Wrap(err, "IndexInternalTxs")
+		}
+		if !indexed {
+			continue
+		}
+		txResult, err := kv.GetByTxHash(hash)
+		if err != nil {
+			return errorsmod.Wrap(err, "IndexInternalTxs")
+		}
+		for _, address := range txAddresses {
+			if err := batch.Set(AddressTxKey(address, txResult.Height, txResult.EthTxIndex), hash.Bytes()); err != nil {
+				return errorsmod.Wrap(err, "set address-tx key")
+			}
+		}
+	}
+	if err := batch.Write(); err != nil {
+		return errorsmod.Wrap(err, "IndexInternalTxs, write batch")
+	}
+	return nil
+}
+
+// GetTxsByAddress returns the hashes of the txs sent by, sent to or creating the address,
+// or involving it in their internal transactions if they are indexed, in the blocks
+// before the height if reverse, otherwise in the blocks after the height. The txs are
+// ordered by block and tx index, descending if reverse. The txs of the last block are
+// all returned even if it exceeds the page size, the second return value tells whether
+// there are more txs beyond the last block.
+func (kv *KVIndexer) GetTxsByAddress(address common.Address, height int64, reverse bool, pageSize int) ([]common.Hash, bool, error) {
+	prefix := append([]byte{KeyPrefixAddressTx}, address.Bytes()...)
+
+	var (
+		it  dbm.Iterator
+		err error
+	)
+	if reverse {
+		start := append(append([]byte{}, prefix...), sdk.Uint64ToBigEndian(0)...)
+		end := append(append([]byte{}, prefix...), sdk.Uint64ToBigEndian(uint64(height))...)
+		it, err = kv.db.ReverseIterator(start, end)
+	} else {
+		start := append(append([]byte{}, prefix...), sdk.Uint64ToBigEndian(uint64(height)+1)...)
+		end := append(append([]byte{}, prefix...), sdk.Uint64ToBigEndian(math.MaxUint64)...)
+		it, err = kv.db.Iterator(start, end)
+	}
+	if err != nil {
+		return nil, false, errorsmod.Wrapf(err, "GetTxsByAddress %s", address.Hex())
+	}
+	defer it.Close()
+
+	var (
+		hashes     []common.Hash
+		lastHeight int64
+	)
+	for ; it.Valid(); it.Next() {
+		key := it.Key()
+		if len(key) != AddressTxKeyLength {
+			return nil, false, fmt.Errorf("wrong address tx key length, expect: %d, got: %d", AddressTxKeyLength, len(key))
+		}
+		blockNumber := int64(sdk.BigEndianToUint64(key[len(prefix) : len(prefix)+8]))
+		if len(hashes) >= pageSize && blockNumber != lastHeight {
+			return hashes, true, nil
+		}
+
+		hashes = append(hashes, common.BytesToHash(it.Value()))
+		lastHeight = blockNumber
+	}
+	return hashes, false, it.Error()
+}
+
+// AddressTxKey returns the key for db entry: `(address, block number, tx index) -> tx hash`
+func AddressTxKey(address common.Address, blockNumber int64, txIndex int32) []byte {
+	bz1 := sdk.Uint64ToBigEndian(uint64(blockNumber))
+	bz2 := sdk.Uint64ToBigEndian(uint64(txIndex))
+	return append(append(append([]byte{KeyPrefixAddressTx}, address.Bytes()...), bz1...), bz2...)
+}
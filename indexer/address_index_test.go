@@ -0,0 +1,122 @@
+package indexer_test
+
+import (
+	"math/big"
+	"strconv"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmlog "github.com/cometbft/cometbft/libs/log"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+	"github.com/evmos/ethermint/indexer"
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKVIndexerAddressTxs(t *testing.T) {
+	encodingConfig := MakeEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	sender := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	signer := tests.NewSigner(priv)
+	ethSigner := ethtypes.LatestSignerForChainID(nil)
+	addr1 := tests.GenerateAddress()
+	addr2 := tests.GenerateAddress()
+	contract := crypto.CreateAddress(sender, 3)
+
+	// buildTx returns the encoded wrapper tx and the hash of a signed eth tx
+	buildTx := func(nonce uint64, to *common.Address) ([]byte, common.Hash) {
+		tx := types.NewTx(nil, nonce, to, big.NewInt(1000), 21000, nil, nil, nil, nil, nil)
+		tx.From = sender.Hex()
+		require.NoError(t, tx.Sign(ethSigner, signer))
+
+		tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), "aphoton")
+		require.NoError(t, err)
+		bz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+		require.NoError(t, err)
+		return bz, tx.AsTransaction().Hash()
+	}
+	txResult := func(hash common.Hash, index int) *abci.ResponseDeliverTx {
+		return &abci.ResponseDeliverTx{
+			Events: []abci.Event{
+				{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: types.AttributeKeyEthereumTxHash, Value: hash.Hex()},
+					{Key: types.AttributeKeyTxIndex, Value: strconv.Itoa(index)},
+					{Key: types.AttributeKeyTxGasUsed, Value: "21000"},
+				}},
+			},
+		}
+	}
+
+	tx1, hash1 := buildTx(0, &addr1)
+	tx2, hash2 := buildTx(1, &addr2)
+	tx3, hash3 := buildTx(2, &addr1)
+	tx4, hash4 := buildTx(3, nil)
+
+	db := dbm.NewMemDB()
+	idxer := indexer.NewKVIndexer(db, tmlog.NewNopLogger(), clientCtx)
+
+	blocks := []struct {
+		height  int64
+		txs     []tmtypes.Tx
+		results []*abci.ResponseDeliverTx
+	}{
+		{1, []tmtypes.Tx{tx1}, []*abci.ResponseDeliverTx{txResult(hash1, 0)}},
+		{2, []tmtypes.Tx{tx2, tx3}, []*abci.ResponseDeliverTx{txResult(hash2, 0), txResult(hash3, 1)}},
+		{3, []tmtypes.Tx{}, []*abci.ResponseDeliverTx{}},
+		{4, []tmtypes.Tx{tx4}, []*abci.ResponseDeliverTx{txResult(hash4, 0)}},
+	}
+	for _, blk := range blocks {
+		block := &tmtypes.Block{Header: tmtypes.Header{Height: blk.height}, Data: tmtypes.Data{Txs: blk.txs}}
+		require.NoError(t, idxer.IndexBlock(block, blk.results))
+	}
+
+	// the internal txs of the txs missing from the index are skipped
+	internal := tests.GenerateAddress()
+	skipped := tests.GenerateAddress()
+	require.NoError(t, idxer.IndexInternalTxs(map[common.Hash][]common.Address{
+		hash2:                         {internal},
+		hash4:                         {internal, sender},
+		common.BytesToHash([]byte{1}): {skipped},
+	}))
+
+	testCases := []struct {
+		name      string
+		address   common.Address
+		height    int64
+		reverse   bool
+		pageSize  int
+		expHashes []common.Hash
+		expMore   bool
+	}{
+		{"sender, before latest", sender, 5, true, 10, []common.Hash{hash4, hash3, hash2, hash1}, false},
+		{"sender, after genesis", sender, 0, false, 10, []common.Hash{hash1, hash2, hash3, hash4}, false},
+		{"sender, page completes the block", sender, 5, true, 2, []common.Hash{hash4, hash3, hash2}, true},
+		{"sender, before height", sender, 2, true, 2, []common.Hash{hash1}, false},
+		{"sender, after height", sender, 1, false, 1, []common.Hash{hash2, hash3}, true},
+		{"recipient", addr1, 5, true, 10, []common.Hash{hash3, hash1}, false},
+		{"recipient, after height", addr2, 2, false, 10, nil, false},
+		{"created contract", contract, 0, false, 10, []common.Hash{hash4}, false},
+		{"internal tx participant", internal, 5, true, 10, []common.Hash{hash4, hash2}, false},
+		{"internal tx of a missing tx", skipped, 5, true, 10, nil, false},
+		{"unknown address", tests.GenerateAddress(), 5, true, 10, nil, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			hashes, more, err := idxer.GetTxsByAddress(tc.address, tc.height, tc.reverse, tc.pageSize)
+			require.NoError(t, err)
+			require.Equal(t, tc.expHashes, hashes)
+			require.Equal(t, tc.expMore, more)
+		})
+	}
+}
@@ -42,7 +42,11 @@ const (
 	TxIndexKeyLength = 1 + 8 + 8
 )
 
-var _ ethermint.EVMTxIndexer = &KVIndexer{}
+var (
+	_ ethermint.EVMTxIndexer      = &KVIndexer{}
+	_ ethermint.EVMLogIndexer     = &KVIndexer{}
+	_ ethermint.EVMAddressIndexer = &KVIndexer{}
+)
 
 // KVIndexer implements a eth tx indexer on a KV db.
 type KVIndexer struct {
@@ -61,6 +65,8 @@ func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVInd
 // - Parses eth Tx infos from cosmos-sdk events for every TxResult
 // - Iterates over all the messages of the Tx
 // - Builds and stores a indexer.TxResult based on parsed events for every message
+// - Stores the tx hash of every message under its sender and recipient addresses
+// - Stores the logs of the block keyed by address and first topic
 func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.ResponseDeliverTx) error {
 	height := block.Header.Height
 
@@ -127,8 +133,21 @@ func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.Response
 			if err := saveTxResult(kv.clientCtx.Codec, batch, txHash, &txResult); err != nil {
 				return errorsmod.Wrapf(err, "IndexBlock %d", height)
 			}
+
+			ethTx := ethMsg.AsTransaction()
+			from, err := ethMsg.GetSender(ethTx.ChainId())
+			if err != nil {
+				kv.logger.Error("Fail to recover tx sender", "err", err, "block", height, "txIndex", txIndex)
+				continue
+			}
+			if err := saveAddressTxs(batch, ethTx, from, &txResult); err != nil {
+				return errorsmod.Wrapf(err, "IndexBlock %d", height)
+			}
 		}
 	}
+	if err := indexBlockLogs(kv.clientCtx.Codec, kv.db, batch, height, txResults); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d", height)
+	}
 	if err := batch.Write(); err != nil {
 		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", block.Height)
 	}
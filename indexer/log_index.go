@@ -0,0 +1,365 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package indexer
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+const (
+	KeyPrefixLog        = 3
+	KeyPrefixLogAddress = 4
+	KeyPrefixLogTopic   = 5
+	KeyPrefixLogBlock   = 6
+	KeyPrefixLogRunEnd  = 8
+
+	// LogBlockKeyLength is the length of log-block key
+	LogBlockKeyLength = 1 + 8
+	// logPositionLength is the length of the (block number, log index) suffix of the log keys
+	logPositionLength = 8 + 8
+)
+
+// indexBlockLogs index the logs of all the txs in a block into the kv db batch,
+// the block is marked as log-indexed even if it doesn't contain any log. The last
+// block of every run of contiguous log-indexed blocks is marked too, so that the gaps
+// are found without iterating over the indexed blocks.
+func indexBlockLogs(codec codec.Codec, db dbm.DB, batch dbm.Batch, height int64, txResults []*abci.ResponseDeliverTx) error {
+	for _, result := range txResults {
+		for _, event := range result.Events {
+			if event.Type != evmtypes.EventTypeTxLog {
+				continue
+			}
+
+			for _, attr := range event.Attributes {
+				if attr.Key != evmtypes.AttributeKeyTxLog {
+					continue
+				}
+
+				var log evmtypes.Log
+				if err := json.Unmarshal([]byte(attr.Value), &log); err != nil {
+					return errorsmod.Wrap(err, "unmarshal tx log")
+				}
+				if err := saveLog(codec, batch, &log); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	if err := batch.Set(LogBlockKey(height), []byte{}); err != nil {
+		return errorsmod.Wrap(err, "set log-block key")
+	}
+	return updateLogRuns(db, batch, height)
+}
+
+// updateLogRuns updates the markers of the runs of contiguous log-indexed blocks when
+// the block at height is indexed: the block ends a run if the next block isn't indexed,
+// and the run ending at the parent block is merged into the one of the block.
+func updateLogRuns(db dbm.DB, batch dbm.Batch, height int64) error {
+	nextIndexed, err := db.Has(LogBlockKey(height + 1))
+	if err != nil {
+		return errorsmod.Wrap(err, "load log-block key")
+	}
+	if !nextIndexed {
+		if err := batch.Set(LogRunEndKey(height), []byte{}); err != nil {
+			return errorsmod.Wrap(err, "set log-run-end key")
+		}
+	}
+
+	parentRunEnd, err := db.Has(LogRunEndKey(height - 1))
+	if err != nil {
+		return errorsmod.Wrap(err, "load log-run-end key")
+	}
+	if parentRunEnd {
+		if err := batch.Delete(LogRunEndKey(height - 1)); err != nil {
+			return errorsmod.Wrap(err, "delete log-run-end key")
+		}
+	}
+	return nil
+}
+
+// saveLog index the log into the kv db batch
+func saveLog(codec codec.Codec, batch dbm.Batch, log *evmtypes.Log) error {
+	bz := codec.MustMarshal(log)
+	if err := batch.Set(LogKey(log.BlockNumber, log.Index), bz); err != nil {
+		return errorsmod.Wrap(err, "set log key")
+	}
+	if err := batch.Set(LogAddressKey(common.HexToAddress(log.Address), log.BlockNumber, log.Index), []byte{}); err != nil {
+		return errorsmod.Wrap(err, "set log-address key")
+	}
+	if len(log.Topics) > 0 {
+		if err := batch.Set(LogTopicKey(common.HexToHash(log.Topics[0]), log.BlockNumber, log.Index), []byte{}); err != nil {
+			return errorsmod.Wrap(err, "set log-topic key")
+		}
+	}
+	return nil
+}
+
+// LogIndexedRange returns the first and the latest block numbers whose logs are indexed,
+// returns -1 if db is empty
+func (kv *KVIndexer) LogIndexedRange() (int64, int64, error) {
+	first, err := loadLogBlock(kv.db, false)
+	if err != nil {
+		return 0, 0, err
+	}
+	last, err := loadLogBlock(kv.db, true)
+	if err != nil {
+		return 0, 0, err
+	}
+	return first, last, nil
+}
+
+// LogIndexedUntil returns the last block of the range such that the logs of every block
+// from the start of the range up to it are indexed, it returns from-1 if the first
+// block isn't indexed. The indexed blocks may have gaps if the indexer db was populated
+// in several runs, they are found through the markers of the run ends.
+func (kv *KVIndexer) LogIndexedUntil(from, to int64) (int64, error) {
+	if from > to {
+		return to, nil
+	}
+	indexed, err := kv.db.Has(LogBlockKey(from))
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "LogIndexedUntil")
+	}
+	if !indexed {
+		return from - 1, nil
+	}
+
+	// the run of the first block ends at the first gap
+	it, err := kv.db.Iterator(LogRunEndKey(from), LogRunEndKey(to))
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "LogIndexedUntil")
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return to, it.Error()
+	}
+	key := it.Key()
+	if len(key) != LogBlockKeyLength {
+		return 0, fmt.Errorf("wrong log run end key length, expect: %d, got: %d", LogBlockKeyLength, len(key))
+	}
+	return int64(sdk.BigEndianToUint64(key[1:])), nil
+}
+
+// IsLogIndexed returns true if the logs of every block within the range are indexed.
+func (kv *KVIndexer) IsLogIndexed(from, to int64) (bool, error) {
+	until, err := kv.LogIndexedUntil(from, to)
+	if err != nil {
+		return false, err
+	}
+	return until == to, nil
+}
+
+// GetLogs finds the logs within the block range emitted by one of the addresses and
+// matching the topic rules, empty addresses or topic rules match any log. At most
+// limit+1 logs are returned, so the caller can tell the limit is exceeded without the
+// logs of the whole range being loaded.
+func (kv *KVIndexer) GetLogs(from, to int64, addresses []common.Address, topics [][]common.Hash, limit int) ([]*ethtypes.Log, error) {
+	// the logs are found through the most selective index
+	var prefixes [][]byte
+	switch {
+	case len(addresses) > 0:
+		for _, address := range addresses {
+			prefixes = append(prefixes, append([]byte{KeyPrefixLogAddress}, address.Bytes()...))
+		}
+	case len(topics) > 0 && len(topics[0]) > 0:
+		for _, topic := range topics[0] {
+			prefixes = append(prefixes, append([]byte{KeyPrefixLogTopic}, topic.Bytes()...))
+		}
+	default:
+		prefixes = [][]byte{{KeyPrefixLog}}
+	}
+
+	// each prefix holds distinct logs, the first limit+1 logs of the range are within the
+	// first limit+1 matching logs of each prefix
+	var logs []*ethtypes.Log
+	for _, prefix := range prefixes {
+		found, err := kv.prefixLogs(prefix, from, to, addresses, topics, limit+1)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "GetLogs %x", prefix)
+		}
+		logs = append(logs, found...)
+	}
+
+	sort.Slice(logs, func(i, j int) bool {
+		if logs[i].BlockNumber != logs[j].BlockNumber {
+			return logs[i].BlockNumber < logs[j].BlockNumber
+		}
+		return logs[i].Index < logs[j].Index
+	})
+	if len(logs) > limit+1 {
+		logs = logs[:limit+1]
+	}
+	if logs == nil {
+		logs = []*ethtypes.Log{}
+	}
+	return logs, nil
+}
+
+// prefixLogs returns the first max logs indexed under the prefix within the block range
+// and matching the addresses and topic rules.
+func (kv *KVIndexer) prefixLogs(
+	prefix []byte,
+	from, to int64,
+	addresses []common.Address,
+	topics [][]common.Hash,
+	max int,
+) ([]*ethtypes.Log, error) {
+	start := append(append([]byte{}, prefix...), sdk.Uint64ToBigEndian(uint64(from))...)
+	end := append(append([]byte{}, prefix...), sdk.Uint64ToBigEndian(uint64(to+1))...)
+	it, err := kv.db.Iterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var logs []*ethtypes.Log
+	for ; it.Valid() && len(logs) < max; it.Next() {
+		key := it.Key()
+		if len(key) != len(prefix)+logPositionLength {
+			return nil, fmt.Errorf("wrong log key length, expect: %d, got: %d", len(prefix)+logPositionLength, len(key))
+		}
+
+		bz := it.Value()
+		if prefix[0] != KeyPrefixLog {
+			suffix := key[len(prefix):]
+			height := sdk.BigEndianToUint64(suffix[:8])
+			logIndex := sdk.BigEndianToUint64(suffix[8:])
+			if bz, err = kv.db.Get(LogKey(height, logIndex)); err != nil {
+				return nil, errorsmod.Wrapf(err, "log %d %d", height, logIndex)
+			}
+			if len(bz) == 0 {
+				return nil, fmt.Errorf("log not found, block: %d, log-index: %d", height, logIndex)
+			}
+		}
+
+		var log evmtypes.Log
+		if err := kv.clientCtx.Codec.Unmarshal(bz, &log); err != nil {
+			return nil, err
+		}
+		ethLog := log.ToEthereum()
+		if matchLog(ethLog, addresses, topics) {
+			logs = append(logs, ethLog)
+		}
+	}
+	return logs, it.Error()
+}
+
+// LogKey returns the key for db entry: `(block number, log index) -> log`
+func LogKey(blockNumber, logIndex uint64) []byte {
+	return logPositionKey([]byte{KeyPrefixLog}, blockNumber, logIndex)
+}
+
+// LogAddressKey returns the key for db entry: `(address, block number, log index) -> nil`
+func LogAddressKey(address common.Address, blockNumber, logIndex uint64) []byte {
+	return logPositionKey(append([]byte{KeyPrefixLogAddress}, address.Bytes()...), blockNumber, logIndex)
+}
+
+// LogTopicKey returns the key for db entry: `(topic0, block number, log index) -> nil`
+func LogTopicKey(topic common.Hash, blockNumber, logIndex uint64) []byte {
+	return logPositionKey(append([]byte{KeyPrefixLogTopic}, topic.Bytes()...), blockNumber, logIndex)
+}
+
+// LogBlockKey returns the key for db entry: `block number -> nil`, marking the logs
+// of the block as indexed
+func LogBlockKey(blockNumber int64) []byte {
+	return append([]byte{KeyPrefixLogBlock}, sdk.Uint64ToBigEndian(uint64(blockNumber))...)
+}
+
+// LogRunEndKey returns the key for db entry: `block number -> nil`, marking the block
+// as the last of a run of contiguous log-indexed blocks
+func LogRunEndKey(blockNumber int64) []byte {
+	return append([]byte{KeyPrefixLogRunEnd}, sdk.Uint64ToBigEndian(uint64(blockNumber))...)
+}
+
+func logPositionKey(prefix []byte, blockNumber, logIndex uint64) []byte {
+	bz1 := sdk.Uint64ToBigEndian(blockNumber)
+	bz2 := sdk.Uint64ToBigEndian(logIndex)
+	return append(append(prefix, bz1...), bz2...)
+}
+
+// loadLogBlock returns the first or the latest log-indexed block number, returns -1 if db is empty
+func loadLogBlock(db dbm.DB, reverse bool) (int64, error) {
+	var (
+		it  dbm.Iterator
+		err error
+	)
+	if reverse {
+		it, err = db.ReverseIterator([]byte{KeyPrefixLogBlock}, []byte{KeyPrefixLogBlock + 1})
+	} else {
+		it, err = db.Iterator([]byte{KeyPrefixLogBlock}, []byte{KeyPrefixLogBlock + 1})
+	}
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "loadLogBlock")
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return -1, nil
+	}
+
+	key := it.Key()
+	if len(key) != LogBlockKeyLength {
+		return 0, fmt.Errorf("wrong log block key length, expect: %d, got: %d", LogBlockKeyLength, len(key))
+	}
+	return int64(sdk.BigEndianToUint64(key[1:])), nil
+}
+
+// matchLog returns true if the log is emitted by one of the addresses and matches the
+// topic rules, with the same semantics as the eth filters.
+func matchLog(log *ethtypes.Log, addresses []common.Address, topics [][]common.Hash) bool {
+	if len(addresses) > 0 && !includesAddress(addresses, log.Address) {
+		return false
+	}
+	if len(topics) > len(log.Topics) {
+		return false
+	}
+	for i, sub := range topics {
+		if len(sub) > 0 && !includesHash(sub, log.Topics[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func includesAddress(addresses []common.Address, address common.Address) bool {
+	for _, addr := range addresses {
+		if addr == address {
+			return true
+		}
+	}
+	return false
+}
+
+func includesHash(hashes []common.Hash, hash common.Hash) bool {
+	for _, h := range hashes {
+		if h == hash {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,148 @@
+package indexer_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmlog "github.com/cometbft/cometbft/libs/log"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/ethermint/indexer"
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKVIndexerLogs(t *testing.T) {
+	encodingConfig := MakeEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	addr1 := tests.GenerateAddress()
+	addr2 := tests.GenerateAddress()
+	topic1 := common.BytesToHash([]byte("topic1"))
+	topic2 := common.BytesToHash([]byte("topic2"))
+
+	newLog := func(address common.Address, height, index uint64, topics ...common.Hash) *ethtypes.Log {
+		if topics == nil {
+			topics = []common.Hash{}
+		}
+		return &ethtypes.Log{
+			Address:     address,
+			Topics:      topics,
+			BlockNumber: height,
+			Index:       uint(index),
+		}
+	}
+	txResult := func(logs ...*ethtypes.Log) *abci.ResponseDeliverTx {
+		attrs := make([]abci.EventAttribute, len(logs))
+		for i, log := range logs {
+			bz, err := json.Marshal(types.NewLogFromEth(log))
+			require.NoError(t, err)
+			attrs[i] = abci.EventAttribute{Key: types.AttributeKeyTxLog, Value: string(bz)}
+		}
+		return &abci.ResponseDeliverTx{
+			Events: []abci.Event{{Type: types.EventTypeTxLog, Attributes: attrs}},
+		}
+	}
+
+	log1 := newLog(addr1, 1, 0, topic1)
+	log2 := newLog(addr2, 1, 1, topic2, topic1)
+	log3 := newLog(addr1, 2, 0, topic2)
+	log4 := newLog(addr2, 2, 1)
+	log5 := newLog(addr1, 4, 0, topic1)
+
+	db := dbm.NewMemDB()
+	idxer := indexer.NewKVIndexer(db, tmlog.NewNopLogger(), clientCtx)
+
+	first, last, err := idxer.LogIndexedRange()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), first)
+	require.Equal(t, int64(-1), last)
+
+	blocks := []struct {
+		height  int64
+		results []*abci.ResponseDeliverTx
+	}{
+		{1, []*abci.ResponseDeliverTx{txResult(log1), txResult(log2)}},
+		{2, []*abci.ResponseDeliverTx{txResult(log3, log4)}},
+		{3, []*abci.ResponseDeliverTx{}},
+		{4, []*abci.ResponseDeliverTx{txResult(log5)}},
+	}
+	for _, blk := range blocks {
+		err := idxer.IndexBlock(&tmtypes.Block{Header: tmtypes.Header{Height: blk.height}}, blk.results)
+		require.NoError(t, err)
+	}
+
+	first, last, err = idxer.LogIndexedRange()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), first)
+	require.Equal(t, int64(4), last)
+
+	indexed, err := idxer.IsLogIndexed(1, 4)
+	require.NoError(t, err)
+	require.True(t, indexed)
+	indexed, err = idxer.IsLogIndexed(2, 5)
+	require.NoError(t, err)
+	require.False(t, indexed)
+
+	// a gap in the indexed blocks
+	require.NoError(t, idxer.IndexBlock(&tmtypes.Block{Header: tmtypes.Header{Height: 6}}, nil))
+	indexed, err = idxer.IsLogIndexed(3, 6)
+	require.NoError(t, err)
+	require.False(t, indexed)
+	indexed, err = idxer.IsLogIndexed(6, 6)
+	require.NoError(t, err)
+	require.True(t, indexed)
+	until, err := idxer.LogIndexedUntil(2, 10)
+	require.NoError(t, err)
+	require.Equal(t, int64(4), until)
+	until, err = idxer.LogIndexedUntil(5, 10)
+	require.NoError(t, err)
+	require.Equal(t, int64(4), until)
+	until, err = idxer.LogIndexedUntil(6, 10)
+	require.NoError(t, err)
+	require.Equal(t, int64(6), until)
+
+	// filling the gap merges the runs of indexed blocks
+	require.NoError(t, idxer.IndexBlock(&tmtypes.Block{Header: tmtypes.Header{Height: 5}}, nil))
+	until, err = idxer.LogIndexedUntil(2, 10)
+	require.NoError(t, err)
+	require.Equal(t, int64(6), until)
+	indexed, err = idxer.IsLogIndexed(1, 6)
+	require.NoError(t, err)
+	require.True(t, indexed)
+
+	testCases := []struct {
+		name      string
+		from, to  int64
+		addresses []common.Address
+		topics    [][]common.Hash
+		limit     int
+		expLogs   []*ethtypes.Log
+	}{
+		{"all logs", 1, 4, nil, nil, 10, []*ethtypes.Log{log1, log2, log3, log4, log5}},
+		{"block range", 2, 3, nil, nil, 10, []*ethtypes.Log{log3, log4}},
+		{"empty range", 3, 3, nil, nil, 10, []*ethtypes.Log{}},
+		{"address", 1, 4, []common.Address{addr1}, nil, 10, []*ethtypes.Log{log1, log3, log5}},
+		{"addresses", 2, 4, []common.Address{addr2, addr1}, nil, 10, []*ethtypes.Log{log3, log4, log5}},
+		{"first topic", 1, 4, nil, [][]common.Hash{{topic1}}, 10, []*ethtypes.Log{log1, log5}},
+		{"first topics", 1, 2, nil, [][]common.Hash{{topic1, topic2}}, 10, []*ethtypes.Log{log1, log2, log3}},
+		{"second topic", 1, 4, nil, [][]common.Hash{{}, {topic1}}, 10, []*ethtypes.Log{log2}},
+		{"address and first topic", 1, 4, []common.Address{addr1}, [][]common.Hash{{topic2}}, 10, []*ethtypes.Log{log3}},
+		{"limit exceeded", 1, 4, nil, nil, 2, []*ethtypes.Log{log1, log2, log3}},
+		{"limit exceeded across addresses", 1, 4, []common.Address{addr2, addr1}, nil, 1, []*ethtypes.Log{log1, log2}},
+		{"limit not exceeded after topic rules", 1, 4, []common.Address{addr1}, [][]common.Hash{{topic1}}, 2, []*ethtypes.Log{log1, log5}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			logs, err := idxer.GetLogs(tc.from, tc.to, tc.addresses, tc.topics, tc.limit)
+			require.NoError(t, err)
+			require.Equal(t, tc.expLogs, logs)
+		})
+	}
+}
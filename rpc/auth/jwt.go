@@ -0,0 +1,166 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package auth
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const (
+	// SecretLength is the length of the JWT secret
+	SecretLength = 32
+
+	// iatLeeway is the max difference between the issued-at claim of a token and the local time,
+	// the same as the Engine API of go-ethereum.
+	iatLeeway = 60 * time.Second
+)
+
+var (
+	// jwtHeader is the only accepted JWT header, the tokens are signed with HS256
+	jwtHeader = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+
+	errMissingToken = errors.New("missing token")
+)
+
+// ObtainJWTSecret loads the hex encoded secret from the file, a new random secret is generated
+// and written to the file if it doesn't exist.
+func ObtainJWTSecret(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err == nil {
+		secret, err := hexutil.Decode("0x" + strings.TrimPrefix(strings.TrimSpace(string(data)), "0x"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid JWT secret in %s: %w", path, err)
+		}
+		if len(secret) != SecretLength {
+			return nil, fmt.Errorf("invalid JWT secret length in %s, expect: %d, got: %d", path, SecretLength, len(secret))
+		}
+		return secret, nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	secret := make([]byte, SecretLength)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(path, []byte(hexutil.Encode(secret)), 0o600); err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
+
+// NewToken returns a HS256 JWT with the issued-at claim, as expected by the Handler.
+func NewToken(secret []byte, iat time.Time) string {
+	claims, _ := json.Marshal(map[string]int64{"iat": iat.Unix()})
+	signingInput := jwtHeader + "." + base64.RawURLEncoding.EncodeToString(claims)
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(sign(secret, signingInput))
+}
+
+// VerifyToken returns an error if the token is not a HS256 JWT signed with the secret, or if
+// its issued-at claim is too far from now.
+func VerifyToken(secret []byte, token string, now time.Time) error {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return errors.New("malformed token")
+	}
+
+	header, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return fmt.Errorf("malformed token header: %w", err)
+	}
+	var h struct {
+		Alg string `json:"alg"`
+	}
+	if err := json.Unmarshal(header, &h); err != nil {
+		return fmt.Errorf("malformed token header: %w", err)
+	}
+	if h.Alg != "HS256" {
+		return fmt.Errorf("unexpected signing method %s", h.Alg)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return fmt.Errorf("malformed token signature: %w", err)
+	}
+	if !hmac.Equal(signature, sign(secret, parts[0]+"."+parts[1])) {
+		return errors.New("invalid token signature")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return fmt.Errorf("malformed token claims: %w", err)
+	}
+	var claims struct {
+		IssuedAt *json.Number `json:"iat"`
+	}
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+	if err := decoder.Decode(&claims); err != nil {
+		return fmt.Errorf("malformed token claims: %w", err)
+	}
+	if claims.IssuedAt == nil {
+		return errors.New("missing issued-at")
+	}
+	iat, err := claims.IssuedAt.Int64()
+	if err != nil {
+		return fmt.Errorf("invalid issued-at: %w", err)
+	}
+	if diff := now.Sub(time.Unix(iat, 0)); diff > iatLeeway || diff < -iatLeeway {
+		return errors.New("stale token")
+	}
+	return nil
+}
+
+// Handler returns a http handler which passes the requests bearing a valid token in their
+// Authorization header to next, and rejects the other ones.
+func Handler(secret []byte, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		err := errMissingToken
+		if token != "" {
+			err = VerifyToken(secret, token, time.Now())
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func sign(secret []byte, signingInput string) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(signingInput))
+	return mac.Sum(nil)
+}
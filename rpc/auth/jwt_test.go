@@ -0,0 +1,105 @@
+package auth_test
+
+import (
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/rpc/auth"
+)
+
+func TestObtainJWTSecret(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config", "jwt.hex")
+
+	secret, err := auth.ObtainJWTSecret(path)
+	require.NoError(t, err)
+	require.Len(t, secret, auth.SecretLength)
+
+	loaded, err := auth.ObtainJWTSecret(path)
+	require.NoError(t, err)
+	require.Equal(t, secret, loaded)
+
+	require.NoError(t, os.WriteFile(path, []byte("0x1234\n"), 0o600))
+	_, err = auth.ObtainJWTSecret(path)
+	require.Error(t, err)
+}
+
+func TestVerifyToken(t *testing.T) {
+	secret := []byte(strings.Repeat("s", auth.SecretLength))
+	now := time.Now()
+	token := auth.NewToken(secret, now)
+	parts := strings.Split(token, ".")
+
+	testCases := []struct {
+		name    string
+		secret  []byte
+		token   string
+		expPass bool
+	}{
+		{"valid", secret, token, true},
+		{"issued in the leeway", secret, auth.NewToken(secret, now.Add(-50*time.Second)), true},
+		{"stale", secret, auth.NewToken(secret, now.Add(-2*time.Minute)), false},
+		{"issued in the future", secret, auth.NewToken(secret, now.Add(2*time.Minute)), false},
+		{"wrong secret", []byte(strings.Repeat("x", auth.SecretLength)), token, false},
+		{"malformed", secret, "abc.def", false},
+		{
+			"other algorithm",
+			secret,
+			base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none","typ":"JWT"}`)) + "." + parts[1] + ".",
+			false,
+		},
+		{
+			"missing issued-at",
+			secret,
+			parts[0] + "." + base64.RawURLEncoding.EncodeToString([]byte(`{}`)) + "." + parts[2],
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := auth.VerifyToken(tc.secret, tc.token, now)
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestHandler(t *testing.T) {
+	secret := []byte(strings.Repeat("s", auth.SecretLength))
+	handler := auth.Handler(secret, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	testCases := []struct {
+		name    string
+		header  string
+		expCode int
+	}{
+		{"missing token", "", http.StatusUnauthorized},
+		{"invalid token", "Bearer abc", http.StatusUnauthorized},
+		{"valid token", "Bearer " + auth.NewToken(secret, time.Now()), http.StatusOK},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{}`))
+			if tc.header != "" {
+				req.Header.Set("Authorization", tc.header)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			require.Equal(t, tc.expCode, rec.Code)
+		})
+	}
+}
@@ -25,11 +25,16 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/crypto"
 	rpctypes "github.com/evmos/ethermint/rpc/types"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 	"github.com/pkg/errors"
 )
 
+// accountRangeMaxResults is the maximum number of accounts returned by `debug_accountRange`
+const accountRangeMaxResults = 256
+
 // GetCode returns the contract code at the given address and block number.
 func (b *Backend) GetCode(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error) {
 	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
@@ -82,6 +87,8 @@ func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNr
 
 	// query storage proofs
 	storageProofs := make([]rpctypes.StorageResult, len(storageKeys))
+	// the storage hash is the root of the evm module store, shared by all the accounts
+	var storageHash common.Hash
 
 	for i, key := range storageKeys {
 		hexKey := common.HexToHash(key)
@@ -90,6 +97,12 @@ func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNr
 			return nil, err
 		}
 
+		if i == 0 {
+			if storageHash, err = rpctypes.StoreRootFromProof(proof); err != nil {
+				return nil, err
+			}
+		}
+
 		storageProofs[i] = rpctypes.StorageResult{
 			Key:   key,
 			Value: (*hexutil.Big)(new(big.Int).SetBytes(valueBz)),
@@ -114,6 +127,17 @@ func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNr
 		return nil, err
 	}
 
+	if len(storageKeys) == 0 {
+		_, storageProof, err := b.queryClient.GetProof(clientCtx, evmtypes.StoreKey, evmtypes.AddressStoragePrefix(address))
+		if err != nil {
+			return nil, err
+		}
+
+		if storageHash, err = rpctypes.StoreRootFromProof(storageProof); err != nil {
+			return nil, err
+		}
+	}
+
 	balance, ok := sdkmath.NewIntFromString(res.Balance)
 	if !ok {
 		return nil, errors.New("invalid balance")
@@ -125,7 +149,7 @@ func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNr
 		Balance:      (*hexutil.Big)(balance.BigInt()),
 		CodeHash:     common.HexToHash(res.CodeHash),
 		Nonce:        hexutil.Uint64(res.Nonce),
-		StorageHash:  common.Hash{}, // NOTE: Ethermint doesn't have a storage hash. TODO: implement?
+		StorageHash:  storageHash,
 		StorageProof: storageProofs,
 	}, nil
 }
@@ -208,3 +232,138 @@ func (b *Backend) GetTransactionCount(address common.Address, blockNum rpctypes.
 	n := hexutil.Uint64(nonce)
 	return &n, nil
 }
+
+// StorageRangeAt returns the storage entries of the contract at the given transaction
+// index of the block, i.e. after the preceding transactions are executed, starting from
+// the start key.
+func (b *Backend) StorageRangeAt(
+	blockHash common.Hash,
+	txIndex int,
+	address common.Address,
+	keyStart hexutil.Bytes,
+	maxResult int,
+) (rpctypes.StorageRangeResult, error) {
+	resBlock, err := b.TendermintBlockByHash(blockHash)
+	if err != nil {
+		return rpctypes.StorageRangeResult{}, err
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		return rpctypes.StorageRangeResult{}, fmt.Errorf("block %s not found", blockHash.Hex())
+	}
+
+	height := resBlock.Block.Height
+	blockRes, err := b.TendermintBlockResultByNumber(&height)
+	if err != nil {
+		return rpctypes.StorageRangeResult{}, err
+	}
+
+	msgs := b.EthMsgsFromTendermintBlock(resBlock, blockRes)
+	if txIndex < 0 || txIndex > len(msgs) {
+		return rpctypes.StorageRangeResult{}, fmt.Errorf("transaction index %d out of range for block %s", txIndex, blockHash.Hex())
+	}
+	if maxResult < 0 {
+		return rpctypes.StorageRangeResult{}, fmt.Errorf("max results cannot be negative, got %d", maxResult)
+	}
+
+	req := &evmtypes.QueryStorageRangeAtRequest{
+		Address:         address.Hex(),
+		StartKey:        keyStart,
+		MaxResults:      uint64(maxResult),
+		Predecessors:    msgs[:txIndex],
+		BlockNumber:     height,
+		BlockHash:       common.Bytes2Hex(resBlock.BlockID.Hash),
+		BlockTime:       resBlock.Block.Time,
+		ProposerAddress: sdk.ConsAddress(resBlock.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+	}
+
+	// minus one to get the context at the beginning of the block
+	contextHeight := height - 1
+	if contextHeight < 1 {
+		// 0 is a special value for `ContextWithHeight`.
+		contextHeight = 1
+	}
+	res, err := b.queryClient.StorageRangeAt(rpctypes.ContextWithHeight(contextHeight), req)
+	if err != nil {
+		return rpctypes.StorageRangeResult{}, err
+	}
+
+	result := rpctypes.StorageRangeResult{
+		Storage: make(map[common.Hash]rpctypes.StorageEntry, len(res.Storage)),
+	}
+	for _, state := range res.Storage {
+		key := common.HexToHash(state.Key)
+		result.Storage[crypto.Keccak256Hash(key.Bytes())] = rpctypes.StorageEntry{
+			Key:   &key,
+			Value: common.HexToHash(state.Value),
+		}
+	}
+	if len(res.NextKey) > 0 {
+		nextKey := common.BytesToHash(res.NextKey)
+		result.NextKey = &nextKey
+	}
+	return result, nil
+}
+
+// AccountRange returns the state of the accounts at the given block, ordered by address
+// and starting from the start address. The max results are capped like geth.
+func (b *Backend) AccountRange(
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	start hexutil.Bytes,
+	maxResults int,
+	noCode, noStorage bool,
+) (state.IteratorDump, error) {
+	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return state.IteratorDump{}, err
+	}
+	resBlock, err := b.TendermintBlockByNumber(blockNum)
+	if err != nil {
+		return state.IteratorDump{}, err
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		return state.IteratorDump{}, fmt.Errorf("block not found for height %d", blockNum)
+	}
+
+	if maxResults > accountRangeMaxResults || maxResults <= 0 {
+		maxResults = accountRangeMaxResults
+	}
+	req := &evmtypes.QueryAccountRangeRequest{
+		Start:      start,
+		MaxResults: uint64(maxResults),
+		NoCode:     noCode,
+		NoStorage:  noStorage,
+	}
+	res, err := b.queryClient.AccountRange(rpctypes.ContextWithHeight(blockNum.Int64()), req)
+	if err != nil {
+		return state.IteratorDump{}, err
+	}
+
+	dump := state.IteratorDump{
+		Accounts: make(map[common.Address]state.DumpAccount, len(res.Accounts)),
+	}
+	// the state root of the block header is the app hash
+	dump.OnRoot(common.BytesToHash(resBlock.Block.AppHash))
+	for _, acc := range res.Accounts {
+		address := common.HexToAddress(acc.Address)
+		account := state.DumpAccount{
+			Balance:  acc.Balance.String(),
+			Nonce:    acc.Nonce,
+			CodeHash: acc.CodeHash,
+			Code:     acc.Code,
+			Address:  &address,
+		}
+		if len(acc.Storage) > 0 {
+			account.Storage = make(map[common.Hash]string, len(acc.Storage))
+			for _, s := range acc.Storage {
+				value := common.HexToHash(s.Value)
+				account.Storage[common.HexToHash(s.Key)] = common.Bytes2Hex(common.TrimLeftZeroes(value.Bytes()))
+			}
+		}
+		dump.Accounts[address] = account
+	}
+	if len(res.Next) > 0 {
+		dump.Next = res.Next
+	}
+	return dump, nil
+}
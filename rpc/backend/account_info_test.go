@@ -4,11 +4,18 @@ import (
 	"fmt"
 	"math/big"
 
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmlog "github.com/cometbft/cometbft/libs/log"
 	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/crypto"
 
 	"github.com/evmos/ethermint/rpc/backend/mocks"
 	rpctypes "github.com/evmos/ethermint/rpc/types"
@@ -80,6 +87,22 @@ func (suite *BackendTestSuite) TestGetProof() {
 	blockNr := rpctypes.NewBlockNumber(big.NewInt(4))
 	address1 := tests.GenerateAddress()
 
+	// build a proof of the storage slot from an evm store
+	evmKey := storetypes.NewKVStoreKey(evmtypes.StoreKey)
+	rs := rootmulti.NewStore(dbm.NewMemDB(), tmlog.NewNopLogger())
+	rs.MountStoreWithDB(evmKey, storetypes.StoreTypeIAVL, nil)
+	suite.Require().NoError(rs.LoadLatestVersion())
+	rs.GetKVStore(evmKey).Set(evmtypes.StateKey(address1, common.HexToHash("0x0").Bytes()), []byte{2})
+	commitID := rs.Commit()
+	storageProof := rs.Query(abci.RequestQuery{
+		Path:   "/evm/key",
+		Data:   evmtypes.StateKey(address1, common.HexToHash("0x0").Bytes()),
+		Height: commitID.Version,
+		Prove:  true,
+	}).ProofOps
+	storageHash, err := rpctypes.StoreRootFromProof(storageProof)
+	suite.Require().NoError(err)
+
 	testCases := []struct {
 		name          string
 		addr          common.Address
@@ -130,12 +153,13 @@ func (suite *BackendTestSuite) TestGetProof() {
 
 				// Use the IAVL height if a valid tendermint height is passed in.
 				ivalHeight := bn.Int64() - 1
-				RegisterABCIQueryWithOptions(
+				RegisterABCIQueryWithProof(
 					client,
 					bn.Int64(),
 					"store/evm/key",
 					evmtypes.StateKey(address1, common.HexToHash("0x0").Bytes()),
 					tmrpcclient.ABCIQueryOptions{Height: ivalHeight, Prove: true},
+					storageProof,
 				)
 				RegisterABCIQueryWithOptions(
 					client,
@@ -152,16 +176,57 @@ func (suite *BackendTestSuite) TestGetProof() {
 				Balance:      (*hexutil.Big)(big.NewInt(0)),
 				CodeHash:     common.HexToHash(""),
 				Nonce:        0x0,
-				StorageHash:  common.Hash{},
+				StorageHash:  storageHash,
 				StorageProof: []rpctypes.StorageResult{
 					{
 						Key:   "0x0",
 						Value: (*hexutil.Big)(big.NewInt(2)),
-						Proof: []string{""},
+						Proof: GetHexProofs(storageProof),
 					},
 				},
 			},
 		},
+		{
+			"pass - storage hash without storage keys",
+			address1,
+			[]string{},
+			rpctypes.BlockNumberOrHash{BlockNumber: &blockNr},
+			func(bn rpctypes.BlockNumber, addr common.Address) {
+				suite.backend.ctx = rpctypes.ContextWithHeight(bn.Int64())
+
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlock(client, bn.Int64(), nil)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterAccount(queryClient, addr, bn.Int64())
+
+				ivalHeight := bn.Int64() - 1
+				RegisterABCIQueryWithProof(
+					client,
+					bn.Int64(),
+					"store/evm/key",
+					evmtypes.AddressStoragePrefix(address1),
+					tmrpcclient.ABCIQueryOptions{Height: ivalHeight, Prove: true},
+					storageProof,
+				)
+				RegisterABCIQueryWithOptions(
+					client,
+					bn.Int64(),
+					"store/acc/key",
+					authtypes.AddressStoreKey(sdk.AccAddress(address1.Bytes())),
+					tmrpcclient.ABCIQueryOptions{Height: ivalHeight, Prove: true},
+				)
+			},
+			true,
+			&rpctypes.AccountResult{
+				Address:      address1,
+				AccountProof: []string{""},
+				Balance:      (*hexutil.Big)(big.NewInt(0)),
+				CodeHash:     common.HexToHash(""),
+				Nonce:        0x0,
+				StorageHash:  storageHash,
+				StorageProof: []rpctypes.StorageResult{},
+			},
+		},
 	}
 	for _, tc := range testCases {
 		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
@@ -406,3 +471,200 @@ func (suite *BackendTestSuite) TestGetTransactionCount() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestStorageRangeAt() {
+	key := common.BigToHash(big.NewInt(1))
+	value := common.BigToHash(big.NewInt(2))
+	nextKey := common.BigToHash(big.NewInt(3))
+	addr := tests.GenerateAddress()
+
+	testCases := []struct {
+		name         string
+		txIndex      int
+		maxResult    int
+		registerMock func()
+		expResult    rpctypes.StorageRangeResult
+		expPass      bool
+	}{
+		{
+			"fail - block not found",
+			0,
+			1,
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockByHashError(client, common.Hash{}, nil)
+			},
+			rpctypes.StorageRangeResult{},
+			false,
+		},
+		{
+			"fail - tx index out of range",
+			1,
+			1,
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockByHash(client, common.Hash{}, nil)
+				RegisterBlockResults(client, 1)
+			},
+			rpctypes.StorageRangeResult{},
+			false,
+		},
+		{
+			"fail - negative max results",
+			0,
+			-1,
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockByHash(client, common.Hash{}, nil)
+				RegisterBlockResults(client, 1)
+			},
+			rpctypes.StorageRangeResult{},
+			false,
+		},
+		{
+			"fail - query error",
+			0,
+			1,
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterBlockByHash(client, common.Hash{}, nil)
+				RegisterBlockResults(client, 1)
+				RegisterStorageRangeAtError(queryClient)
+			},
+			rpctypes.StorageRangeResult{},
+			false,
+		},
+		{
+			"pass - storage keyed by preimage hash",
+			0,
+			1,
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterBlockByHash(client, common.Hash{}, nil)
+				RegisterBlockResults(client, 1)
+				RegisterStorageRangeAt(queryClient, []evmtypes.State{{Key: key.Hex(), Value: value.Hex()}}, nextKey.Bytes())
+			},
+			rpctypes.StorageRangeResult{
+				Storage: map[common.Hash]rpctypes.StorageEntry{
+					crypto.Keccak256Hash(key.Bytes()): {Key: &key, Value: value},
+				},
+				NextKey: &nextKey,
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+			tc.registerMock()
+
+			result, err := suite.backend.StorageRangeAt(common.Hash{}, tc.txIndex, addr, nil, tc.maxResult)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResult, result)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestAccountRange() {
+	blockNr := rpctypes.NewBlockNumber(big.NewInt(1))
+	addr := tests.GenerateAddress()
+	key := common.BigToHash(big.NewInt(1))
+	next := tests.GenerateAddress().Bytes()
+	// the mocked blocks have an empty app hash
+	root := fmt.Sprintf("%x", common.Hash{})
+
+	testCases := []struct {
+		name         string
+		maxResults   int
+		registerMock func()
+		expDump      state.IteratorDump
+		expPass      bool
+	}{
+		{
+			"fail - block not found",
+			1,
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			state.IteratorDump{},
+			false,
+		},
+		{
+			"fail - query error",
+			1,
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterBlock(client, 1, nil)
+				RegisterAccountRangeError(queryClient, &evmtypes.QueryAccountRangeRequest{MaxResults: 1})
+			},
+			state.IteratorDump{},
+			false,
+		},
+		{
+			"pass - max results capped",
+			0,
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterBlock(client, 1, nil)
+				RegisterAccountRange(queryClient, &evmtypes.QueryAccountRangeRequest{MaxResults: accountRangeMaxResults}, nil, nil)
+			},
+			state.IteratorDump{Root: root, Accounts: map[common.Address]state.DumpAccount{}},
+			true,
+		},
+		{
+			"pass - accounts with storage",
+			1,
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterBlock(client, 1, nil)
+				RegisterAccountRange(queryClient, &evmtypes.QueryAccountRangeRequest{MaxResults: 1}, []evmtypes.AccountDump{
+					{
+						Address:  addr.Hex(),
+						Balance:  sdk.NewInt(100),
+						Nonce:    1,
+						CodeHash: crypto.Keccak256(nil),
+						Storage:  []evmtypes.State{{Key: key.Hex(), Value: common.BigToHash(big.NewInt(2)).Hex()}},
+					},
+				}, next)
+			},
+			state.IteratorDump{
+				Root: root,
+				Accounts: map[common.Address]state.DumpAccount{
+					addr: {
+						Balance:  "100",
+						Nonce:    1,
+						CodeHash: crypto.Keccak256(nil),
+						Storage:  map[common.Hash]string{key: "02"},
+						Address:  &addr,
+					},
+				},
+				Next: next,
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+			tc.registerMock()
+
+			dump, err := suite.backend.AccountRange(rpctypes.BlockNumberOrHash{BlockNumber: &blockNr}, nil, tc.maxResults, false, false)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expDump, dump)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -28,6 +28,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/state"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -70,6 +71,8 @@ type EVMBackend interface {
 	RPCEVMTimeout() time.Duration // global timeout for eth_call over rpc: DoS protection
 	RPCTxFeeCap() float64         // RPCTxFeeCap is the global transaction fee(price * gaslimit) cap for send-transaction variants. The unit is ether.
 	RPCMinGasPrice() int64
+	RPCBlockRangeCap() int32       // max block range allowed for the queries over a range of blocks
+	RPCTraceBlockChunkSize() int32 // max number of transactions traced by a single query when tracing a block
 
 	// Sign Tx
 	Sign(address common.Address, data hexutil.Bytes) (hexutil.Bytes, error)
@@ -101,6 +104,8 @@ type EVMBackend interface {
 	GetStorageAt(address common.Address, key string, blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error)
 	GetProof(address common.Address, storageKeys []string, blockNrOrHash rpctypes.BlockNumberOrHash) (*rpctypes.AccountResult, error)
 	GetTransactionCount(address common.Address, blockNum rpctypes.BlockNumber) (*hexutil.Uint64, error)
+	StorageRangeAt(blockHash common.Hash, txIndex int, address common.Address, keyStart hexutil.Bytes, maxResult int) (rpctypes.StorageRangeResult, error)
+	AccountRange(blockNrOrHash rpctypes.BlockNumberOrHash, start hexutil.Bytes, maxResults int, noCode, noStorage bool) (state.IteratorDump, error)
 
 	// Chain Info
 	ChainID() (*hexutil.Big, error)
@@ -115,10 +120,14 @@ type EVMBackend interface {
 
 	// Tx Info
 	GetTransactionByHash(txHash common.Hash) (*rpctypes.RPCTransaction, error)
+	GetRawTransaction(txHash common.Hash) (hexutil.Bytes, error)
 	GetTxByEthHash(txHash common.Hash) (*ethermint.TxResult, error)
 	GetTxByTxIndex(height int64, txIndex uint) (*ethermint.TxResult, error)
+	GetTxsByAddress(address common.Address, height int64, reverse bool, pageSize int) ([]common.Hash, bool, error)
 	GetTransactionByBlockAndIndex(block *tmrpctypes.ResultBlock, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
 	GetTransactionReceipt(hash common.Hash) (map[string]interface{}, error)
+	GetBlockReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]map[string]interface{}, error)
+	GetRawReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]hexutil.Bytes, error)
 	GetTransactionByBlockHashAndIndex(hash common.Hash, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
 	GetTransactionByBlockNumberAndIndex(blockNum rpctypes.BlockNumber, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
 
@@ -126,18 +135,39 @@ type EVMBackend interface {
 	Resend(args evmtypes.TransactionArgs, gasPrice *hexutil.Big, gasLimit *hexutil.Uint64) (common.Hash, error)
 	SendRawTransaction(data hexutil.Bytes) (common.Hash, error)
 	SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.TransactionArgs, error)
-	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error)
-	DoCall(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber) (*evmtypes.MsgEthereumTxResponse, error)
+	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber, overrides *rpctypes.StateOverride) (hexutil.Uint64, error)
+	DoCall(
+		args evmtypes.TransactionArgs,
+		blockNr rpctypes.BlockNumber,
+		overrides *rpctypes.StateOverride,
+		blockOverrides *rpctypes.BlockOverrides,
+	) (*evmtypes.MsgEthereumTxResponse, error)
+	SimulateV1(opts rpctypes.SimOpts, blockNr rpctypes.BlockNumber) ([]evmtypes.SimBlockResult, error)
+	CallMany(
+		args []evmtypes.TransactionArgs,
+		blockNr rpctypes.BlockNumber,
+		overrides *rpctypes.StateOverride,
+	) ([]evmtypes.SimCallResult, error)
+	CreateAccessList(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber) (*rpctypes.AccessListResult, error)
 	GasPrice() (*hexutil.Big, error)
 
+	// TxPool API
+	TxPoolContent() (map[common.Address][]*evmtypes.MsgEthereumTx, map[common.Address][]*evmtypes.MsgEthereumTx, error)
+	TxPoolContentFrom(address common.Address) ([]*evmtypes.MsgEthereumTx, []*evmtypes.MsgEthereumTx, error)
+	TxPoolStatus() (int, int, error)
+
 	// Filter API
 	GetLogs(hash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error)
+	GetLogsFromIndex(from, to int64, addresses []common.Address, topics [][]common.Hash, limit int) ([]*ethtypes.Log, int64, error)
 	BloomStatus() (uint64, uint64)
 
 	// Tracing
 	TraceTransaction(hash common.Hash, config *evmtypes.TraceConfig) (interface{}, error)
+	TraceCall(args evmtypes.TransactionArgs, blockNrOrHash rpctypes.BlockNumberOrHash, config *rpctypes.TraceCallConfig) (interface{}, error)
 	TraceBlock(height rpctypes.BlockNumber, config *evmtypes.TraceConfig, block *tmrpctypes.ResultBlock) ([]*evmtypes.TxTraceResult, error)
+	TraceBlockStream(height rpctypes.BlockNumber, config *evmtypes.TraceConfig, block *tmrpctypes.ResultBlock, fn func(*evmtypes.TxTraceResult) error) error
+	IntermediateRoots(height rpctypes.BlockNumber, block *tmrpctypes.ResultBlock) ([]common.Hash, error)
 }
 
 var _ BackendI = (*Backend)(nil)
@@ -152,6 +182,7 @@ type Backend struct {
 	cfg                 config.Config
 	allowUnprotectedTxs bool
 	indexer             ethermint.EVMTxIndexer
+	cache               *BlockCache // nil if the caching is disabled
 }
 
 // NewBackend creates a new Backend instance for cosmos and ethereum namespaces
@@ -161,6 +192,7 @@ func NewBackend(
 	clientCtx client.Context,
 	allowUnprotectedTxs bool,
 	indexer ethermint.EVMTxIndexer,
+	cache *BlockCache,
 ) *Backend {
 	chainID, err := ethermint.ParseChainID(clientCtx.ChainID)
 	if err != nil {
@@ -181,5 +213,6 @@ func NewBackend(
 		cfg:                 appConf,
 		allowUnprotectedTxs: allowUnprotectedTxs,
 		indexer:             indexer,
+		cache:               cache,
 	}
 }
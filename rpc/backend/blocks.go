@@ -181,6 +181,8 @@ func (b *Backend) TendermintBlockByNumber(blockNum rpctypes.BlockNumber) (*tmrpc
 			return nil, err
 		}
 		height = int64(n)
+	} else if resBlock, ok := b.cache.getBlock(height); ok {
+		return resBlock, nil
 	}
 	resBlock, err := b.clientCtx.Client.Block(b.ctx, &height)
 	if err != nil {
@@ -193,23 +195,40 @@ func (b *Backend) TendermintBlockByNumber(blockNum rpctypes.BlockNumber) (*tmrpc
 		return nil, nil
 	}
 
+	b.cache.addBlock(resBlock)
 	return resBlock, nil
 }
 
 // TendermintBlockResultByNumber returns a Tendermint-formatted block result
 // by block number
 func (b *Backend) TendermintBlockResultByNumber(height *int64) (*tmrpctypes.ResultBlockResults, error) {
+	if height != nil && *height > 0 {
+		if blockRes, ok := b.cache.getBlockResults(*height); ok {
+			return blockRes, nil
+		}
+	}
+
 	sc, ok := b.clientCtx.Client.(tmrpcclient.SignClient)
 	if !ok {
 		b.logger.Error("invalid rpc client")
 		return nil, errors.New("invalid rpc client")
 	}
 
-	return sc.BlockResults(b.ctx, height)
+	blockRes, err := sc.BlockResults(b.ctx, height)
+	if err != nil {
+		return nil, err
+	}
+
+	b.cache.addBlockResults(blockRes)
+	return blockRes, nil
 }
 
 // TendermintBlockByHash returns a Tendermint-formatted block by block number
 func (b *Backend) TendermintBlockByHash(blockHash common.Hash) (*tmrpctypes.ResultBlock, error) {
+	if resBlock, ok := b.cache.getBlockByHash(blockHash); ok {
+		return resBlock, nil
+	}
+
 	sc, ok := b.clientCtx.Client.(tmrpcclient.SignClient)
 	if !ok {
 		b.logger.Error("invalid rpc client")
@@ -226,6 +245,7 @@ func (b *Backend) TendermintBlockByHash(blockHash common.Hash) (*tmrpctypes.Resu
 		return nil, nil
 	}
 
+	b.cache.addBlock(resBlock)
 	return resBlock, nil
 }
 
@@ -499,15 +519,23 @@ func (b *Backend) EthBlockFromTendermintBlock(
 ) (*ethtypes.Block, error) {
 	block := resBlock.Block
 	height := block.Height
+	if ethBlock, ok := b.cache.getEthBlock(height); ok {
+		return ethBlock, nil
+	}
+
+	// the block is only cached if it's complete
+	complete := true
 	bloom, err := b.BlockBloom(blockRes)
 	if err != nil {
 		b.logger.Debug("HeaderByNumber BlockBloom failed", "height", height)
+		complete = false
 	}
 
 	baseFee, err := b.BaseFee(blockRes)
 	if err != nil {
 		// handle error for pruned node and log
 		b.logger.Error("failed to fetch Base Fee from prunned block. Check node prunning configuration", "height", height, "error", err)
+		complete = false
 	}
 
 	ethHeader := rpctypes.EthHeaderFromTendermint(block.Header, bloom, baseFee)
@@ -520,5 +548,8 @@ func (b *Backend) EthBlockFromTendermintBlock(
 
 	// TODO: add tx receipts
 	ethBlock := ethtypes.NewBlock(ethHeader, txs, nil, nil, trie.NewStackTrie(nil))
+	if complete {
+		b.cache.addEthBlock(height, ethBlock)
+	}
 	return ethBlock, nil
 }
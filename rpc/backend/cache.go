@@ -0,0 +1,175 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package backend
+
+import (
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/metrics"
+	lru "github.com/hashicorp/golang-lru"
+)
+
+// queryCache is a size bounded LRU cache of a query result, counting its hits and misses in
+// the go-ethereum metrics registry exposed by the EVM metrics server.
+type queryCache struct {
+	lru    *lru.Cache
+	hits   metrics.Counter
+	misses metrics.Counter
+}
+
+func newQueryCache(name string, size int) *queryCache {
+	cache, err := lru.New(size)
+	if err != nil {
+		panic(err)
+	}
+	return &queryCache{
+		lru:    cache,
+		hits:   metrics.GetOrRegisterCounter("rpc/cache/"+name+"/hit", nil),
+		misses: metrics.GetOrRegisterCounter("rpc/cache/"+name+"/miss", nil),
+	}
+}
+
+func (c *queryCache) get(key interface{}) (interface{}, bool) {
+	value, ok := c.lru.Get(key)
+	if ok {
+		c.hits.Inc(1)
+	} else {
+		c.misses.Inc(1)
+	}
+	return value, ok
+}
+
+// BlockCache caches the results of the queries of the committed blocks, which never change
+// since CometBFT blocks are final. The queries of the latest or pending block bypass it.
+// A single BlockCache is shared by the backends of all the JSON-RPC namespaces.
+type BlockCache struct {
+	blocks       *queryCache // height -> *tmrpctypes.ResultBlock
+	blockHeights *queryCache // block hash -> height
+	blockResults *queryCache // height -> *tmrpctypes.ResultBlockResults
+	ethBlocks    *queryCache // height -> *ethtypes.Block
+	receipts     *queryCache // tx hash -> receipt
+}
+
+// NewBlockCache creates a BlockCache holding up to size entries per query, it returns nil,
+// which disables the caching, if the size is not positive.
+func NewBlockCache(size int) *BlockCache {
+	if size <= 0 {
+		return nil
+	}
+	return &BlockCache{
+		blocks:       newQueryCache("blocks", size),
+		blockHeights: newQueryCache("blockhashes", size),
+		blockResults: newQueryCache("blockresults", size),
+		ethBlocks:    newQueryCache("ethblocks", size),
+		receipts:     newQueryCache("receipts", size),
+	}
+}
+
+func (c *BlockCache) getBlock(height int64) (*tmrpctypes.ResultBlock, bool) {
+	if c == nil {
+		return nil, false
+	}
+	value, ok := c.blocks.get(height)
+	if !ok {
+		return nil, false
+	}
+	return value.(*tmrpctypes.ResultBlock), true
+}
+
+func (c *BlockCache) getBlockByHash(hash common.Hash) (*tmrpctypes.ResultBlock, bool) {
+	if c == nil {
+		return nil, false
+	}
+	height, ok := c.blockHeights.get(hash)
+	if !ok {
+		return nil, false
+	}
+	return c.getBlock(height.(int64))
+}
+
+func (c *BlockCache) addBlock(resBlock *tmrpctypes.ResultBlock) {
+	if c == nil || resBlock == nil || resBlock.Block == nil {
+		return
+	}
+	c.blocks.lru.Add(resBlock.Block.Height, resBlock)
+	c.blockHeights.lru.Add(common.BytesToHash(resBlock.Block.Hash()), resBlock.Block.Height)
+}
+
+func (c *BlockCache) getBlockResults(height int64) (*tmrpctypes.ResultBlockResults, bool) {
+	if c == nil {
+		return nil, false
+	}
+	value, ok := c.blockResults.get(height)
+	if !ok {
+		return nil, false
+	}
+	return value.(*tmrpctypes.ResultBlockResults), true
+}
+
+func (c *BlockCache) addBlockResults(blockRes *tmrpctypes.ResultBlockResults) {
+	if c == nil || blockRes == nil {
+		return
+	}
+	c.blockResults.lru.Add(blockRes.Height, blockRes)
+}
+
+func (c *BlockCache) getEthBlock(height int64) (*ethtypes.Block, bool) {
+	if c == nil {
+		return nil, false
+	}
+	value, ok := c.ethBlocks.get(height)
+	if !ok {
+		return nil, false
+	}
+	return value.(*ethtypes.Block), true
+}
+
+func (c *BlockCache) addEthBlock(height int64, block *ethtypes.Block) {
+	if c == nil {
+		return
+	}
+	c.ethBlocks.lru.Add(height, block)
+}
+
+// getReceipt returns a copy of the cached receipt, so that the callers can extend it.
+func (c *BlockCache) getReceipt(hash common.Hash) (map[string]interface{}, bool) {
+	if c == nil {
+		return nil, false
+	}
+	value, ok := c.receipts.get(hash)
+	if !ok {
+		return nil, false
+	}
+	receipt := value.(map[string]interface{})
+	res := make(map[string]interface{}, len(receipt))
+	for k, v := range receipt {
+		res[k] = v
+	}
+	return res, true
+}
+
+// addReceipt caches a copy of the receipt, so that the callers can extend it.
+func (c *BlockCache) addReceipt(hash common.Hash, receipt map[string]interface{}) {
+	if c == nil || receipt == nil {
+		return
+	}
+	res := make(map[string]interface{}, len(receipt))
+	for k, v := range receipt {
+		res[k] = v
+	}
+	c.receipts.lru.Add(hash, res)
+}
@@ -0,0 +1,61 @@
+package backend
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/ethermint/rpc/backend/mocks"
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+)
+
+func (suite *BackendTestSuite) TestBlockCache() {
+	suite.backend.cache = NewBlockCache(10)
+	client := suite.backend.clientCtx.Client.(*mocks.Client)
+	height := int64(1)
+
+	resBlock, _ := RegisterBlock(client, height, nil)
+	blockRes, _ := RegisterBlockResults(client, height)
+
+	for i := 0; i < 2; i++ {
+		block, err := suite.backend.TendermintBlockByNumber(rpctypes.BlockNumber(height))
+		suite.Require().NoError(err)
+		suite.Require().Equal(resBlock, block)
+
+		res, err := suite.backend.TendermintBlockResultByNumber(&height)
+		suite.Require().NoError(err)
+		suite.Require().Equal(blockRes, res)
+	}
+	client.AssertNumberOfCalls(suite.T(), "Block", 1)
+	client.AssertNumberOfCalls(suite.T(), "BlockResults", 1)
+
+	// the block is also cached by hash
+	block, err := suite.backend.TendermintBlockByHash(common.BytesToHash(resBlock.Block.Hash()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(resBlock, block)
+	client.AssertNotCalled(suite.T(), "BlockByHash")
+
+	// the latest block results are not looked up in the cache
+	_, err = suite.backend.TendermintBlockResultByNumber(nil)
+	suite.Require().NoError(err)
+	client.AssertNumberOfCalls(suite.T(), "BlockResults", 2)
+}
+
+func (suite *BackendTestSuite) TestBlockCacheReceipt() {
+	cache := NewBlockCache(10)
+	hash := common.BytesToHash([]byte{1})
+	cache.addReceipt(hash, map[string]interface{}{"status": 1})
+
+	receipt, ok := cache.getReceipt(hash)
+	suite.Require().True(ok)
+	receipt["timestamp"] = 2
+
+	receipt, ok = cache.getReceipt(hash)
+	suite.Require().True(ok)
+	suite.Require().Equal(map[string]interface{}{"status": 1}, receipt)
+
+	// a nil cache is disabled
+	var disabled *BlockCache
+	disabled.addReceipt(hash, receipt)
+	_, ok = disabled.getReceipt(hash)
+	suite.Require().False(ok)
+	suite.Require().Nil(NewBlockCache(0))
+}
@@ -23,6 +23,7 @@ import (
 	"math/big"
 
 	errorsmod "cosmossdk.io/errors"
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
@@ -289,7 +290,7 @@ func (b *Backend) SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.Transac
 		}
 
 		blockNr := rpctypes.NewBlockNumber(big.NewInt(0))
-		estimated, err := b.EstimateGas(callArgs, &blockNr)
+		estimated, err := b.EstimateGas(callArgs, &blockNr, nil)
 		if err != nil {
 			return args, err
 		}
@@ -304,8 +305,11 @@ func (b *Backend) SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.Transac
 	return args, nil
 }
 
-// EstimateGas returns an estimate of gas usage for the given smart contract call.
-func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error) {
+// EstimateGas returns an estimate of gas usage for the given smart contract call,
+// the optional state overrides are applied before the estimation.
+func (b *Backend) EstimateGas(
+	args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber, overrides *rpctypes.StateOverride,
+) (hexutil.Uint64, error) {
 	blockNr := rpctypes.EthPendingBlockNumber
 	if blockNrOptional != nil {
 		blockNr = *blockNrOptional
@@ -329,6 +333,12 @@ func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rp
 		ChainId:         b.chainID.Int64(),
 	}
 
+	if overrides != nil {
+		if req.Overrides, err = json.Marshal(overrides); err != nil {
+			return 0, err
+		}
+	}
+
 	// From ContextWithHeight: if the provided height is 0,
 	// it will return an empty context and the gRPC query will use
 	// the latest block height for querying.
@@ -340,9 +350,13 @@ func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rp
 }
 
 // DoCall performs a simulated call operation through the evmtypes. It returns the
-// estimated gas used on the operation or an error if fails.
+// estimated gas used on the operation or an error if fails. The optional state
+// and block overrides are applied before the call is executed.
 func (b *Backend) DoCall(
-	args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber,
+	args evmtypes.TransactionArgs,
+	blockNr rpctypes.BlockNumber,
+	overrides *rpctypes.StateOverride,
+	blockOverrides *rpctypes.BlockOverrides,
 ) (*evmtypes.MsgEthereumTxResponse, error) {
 	bz, err := json.Marshal(&args)
 	if err != nil {
@@ -361,6 +375,17 @@ func (b *Backend) DoCall(
 		ChainId:         b.chainID.Int64(),
 	}
 
+	if overrides != nil {
+		if req.Overrides, err = json.Marshal(overrides); err != nil {
+			return nil, err
+		}
+	}
+	if blockOverrides != nil {
+		if req.BlockOverrides, err = json.Marshal(blockOverrides); err != nil {
+			return nil, err
+		}
+	}
+
 	// From ContextWithHeight: if the provided height is 0,
 	// it will return an empty context and the gRPC query will use
 	// the latest block height for querying.
@@ -395,6 +420,165 @@ func (b *Backend) DoCall(
 	return res, nil
 }
 
+// SimulateV1 executes the blocks of calls on top of the given block, every call
+// sees the state changes of the previous ones and nothing is committed. The block
+// number and timestamp of the simulated blocks default to the ones of the
+// previous block increased by 1 and 12 seconds respectively.
+func (b *Backend) SimulateV1(opts rpctypes.SimOpts, blockNr rpctypes.BlockNumber) ([]evmtypes.SimBlockResult, error) {
+	if len(opts.BlockStateCalls) == 0 {
+		return nil, errors.New("empty input")
+	}
+	if opts.Validation || opts.TraceTransfers {
+		return nil, errors.New("validation and traceTransfers are not supported")
+	}
+
+	header, err := b.TendermintBlockByNumber(blockNr)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	// the first simulated block follows the queried one, the keeper derives the
+	// following ones from it and validates the overridden numbers and times
+	blocks := opts.BlockStateCalls
+	overrides := evmtypes.BlockOverrides{}
+	if blocks[0].BlockOverrides != nil {
+		overrides = *blocks[0].BlockOverrides
+	}
+	if overrides.Number == nil {
+		overrides.Number = (*hexutil.Big)(big.NewInt(header.Block.Height + 1))
+	}
+	if overrides.Time == nil {
+		overrides.Time = (*hexutil.Big)(big.NewInt(header.Block.Time.Unix() + evmtypes.SimBlockTimeIncrement))
+	}
+	blocks[0].BlockOverrides = &overrides
+
+	return b.simulate(blocks, blockNr, header)
+}
+
+// CallMany executes the calls in order on top of the given block, every call sees
+// the state changes of the previous ones and nothing is committed. The optional
+// state overrides are applied before the first call.
+func (b *Backend) CallMany(
+	args []evmtypes.TransactionArgs,
+	blockNr rpctypes.BlockNumber,
+	overrides *rpctypes.StateOverride,
+) ([]evmtypes.SimCallResult, error) {
+	header, err := b.TendermintBlockByNumber(blockNr)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	blocks := []evmtypes.SimBlock{{StateOverrides: overrides, Calls: args}}
+	results, err := b.simulate(blocks, blockNr, header)
+	if err != nil {
+		return nil, err
+	}
+	return results[0].Calls, nil
+}
+
+// simulate performs the simulation of the blocks through the evm `SimulateCalls` query.
+func (b *Backend) simulate(
+	blocks []evmtypes.SimBlock, blockNr rpctypes.BlockNumber, header *tmrpctypes.ResultBlock,
+) ([]evmtypes.SimBlockResult, error) {
+	bz, err := json.Marshal(blocks)
+	if err != nil {
+		return nil, err
+	}
+
+	req := evmtypes.QuerySimulateCallsRequest{
+		Blocks:          bz,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+	}
+
+	// From ContextWithHeight: if the provided height is 0,
+	// it will return an empty context and the gRPC query will use
+	// the latest block height for querying.
+	ctx := rpctypes.ContextWithHeight(blockNr.Int64())
+	timeout := b.RPCEVMTimeout()
+
+	// Setup context so it may be canceled the call has completed
+	// or, in case of unmetered gas, setup a context with a timeout.
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	res, err := b.queryClient.SimulateCalls(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []evmtypes.SimBlockResult
+	if err := json.Unmarshal(res.Data, &results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// CreateAccessList returns the EIP-2930 access list accessed by the call on top of
+// the given block, along with the gas used when the access list is included.
+func (b *Backend) CreateAccessList(
+	args evmtypes.TransactionArgs,
+	blockNr rpctypes.BlockNumber,
+) (*rpctypes.AccessListResult, error) {
+	bz, err := json.Marshal(&args)
+	if err != nil {
+		return nil, err
+	}
+	header, err := b.TendermintBlockByNumber(blockNr)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	req := evmtypes.EthCallRequest{
+		Args:            bz,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+	}
+
+	// From ContextWithHeight: if the provided height is 0,
+	// it will return an empty context and the gRPC query will use
+	// the latest block height for querying.
+	ctx := rpctypes.ContextWithHeight(blockNr.Int64())
+	timeout := b.RPCEVMTimeout()
+
+	// Setup context so it may be canceled the call has completed
+	// or, in case of unmetered gas, setup a context with a timeout.
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	res, err := b.queryClient.CreateAccessList(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	// an empty access list is encoded as an empty array as in geth
+	accessList := res.AccessList.ToEthAccessList()
+	if *accessList == nil {
+		*accessList = ethtypes.AccessList{}
+	}
+
+	return &rpctypes.AccessListResult{
+		Accesslist: accessList,
+		Error:      res.VmError,
+		GasUsed:    hexutil.Uint64(res.GasUsed),
+	}, nil
+}
+
 // GasPrice returns the current gas price based on Ethermint's gas price oracle.
 func (b *Backend) GasPrice() (*hexutil.Big, error) {
 	var (
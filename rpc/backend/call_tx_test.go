@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"math/big"
 
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/evmos/ethermint/rpc/backend/mocks"
 	rpctypes "github.com/evmos/ethermint/rpc/types"
@@ -207,7 +209,7 @@ func (suite *BackendTestSuite) TestResend() {
 				RegisterEstimateGas(queryClient, callArgs)
 				RegisterParams(queryClient, &header, 1)
 				RegisterParamsWithoutHeader(queryClient, 1)
-				RegisterUnconfirmedTxsError(client, nil)
+				RegisterUnconfirmedTxsError(client)
 			},
 			evmtypes.TransactionArgs{
 				Nonce:                &txNonce,
@@ -235,7 +237,7 @@ func (suite *BackendTestSuite) TestResend() {
 				RegisterEstimateGas(queryClient, callArgs)
 				RegisterParams(queryClient, &header, 1)
 				RegisterParamsWithoutHeader(queryClient, 1)
-				RegisterUnconfirmedTxsEmpty(client, nil)
+				RegisterUnconfirmedTxsEmpty(client)
 			},
 			evmtypes.TransactionArgs{
 				Nonce:                &txNonce,
@@ -380,12 +382,17 @@ func (suite *BackendTestSuite) TestDoCall() {
 	}
 	argsBz, err := json.Marshal(callArgs)
 	suite.Require().NoError(err)
+	nonce := hexutil.Uint64(1)
+	overrides := rpctypes.StateOverride{toAddr: {Nonce: &nonce}}
+	overridesBz, err := json.Marshal(overrides)
+	suite.Require().NoError(err)
 
 	testCases := []struct {
 		name         string
 		registerMock func()
 		blockNum     rpctypes.BlockNumber
 		callArgs     evmtypes.TransactionArgs
+		overrides    *rpctypes.StateOverride
 		expEthTx     *evmtypes.MsgEthereumTxResponse
 		expPass      bool
 	}{
@@ -399,6 +406,7 @@ func (suite *BackendTestSuite) TestDoCall() {
 			},
 			rpctypes.BlockNumber(1),
 			callArgs,
+			nil,
 			&evmtypes.MsgEthereumTxResponse{},
 			false,
 		},
@@ -412,6 +420,25 @@ func (suite *BackendTestSuite) TestDoCall() {
 			},
 			rpctypes.BlockNumber(1),
 			callArgs,
+			nil,
+			&evmtypes.MsgEthereumTxResponse{},
+			true,
+		},
+		{
+			"pass - Returned transaction response with state overrides",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterBlock(client, 1, bz)
+				RegisterEthCall(queryClient, &evmtypes.EthCallRequest{
+					Args:      argsBz,
+					ChainId:   suite.backend.chainID.Int64(),
+					Overrides: overridesBz,
+				})
+			},
+			rpctypes.BlockNumber(1),
+			callArgs,
+			&overrides,
 			&evmtypes.MsgEthereumTxResponse{},
 			true,
 		},
@@ -422,7 +449,7 @@ func (suite *BackendTestSuite) TestDoCall() {
 			suite.SetupTest() // reset test and queries
 			tc.registerMock()
 
-			msgEthTx, err := suite.backend.DoCall(tc.callArgs, tc.blockNum)
+			msgEthTx, err := suite.backend.DoCall(tc.callArgs, tc.blockNum, tc.overrides, nil)
 
 			if tc.expPass {
 				suite.Require().Equal(tc.expEthTx, msgEthTx)
@@ -433,6 +460,225 @@ func (suite *BackendTestSuite) TestDoCall() {
 	}
 }
 
+func (suite *BackendTestSuite) TestSimulateV1() {
+	_, bz := suite.buildEthereumTx()
+	toAddr := tests.GenerateAddress()
+	callArgs := evmtypes.TransactionArgs{To: &toAddr}
+	callResult := evmtypes.SimCallResult{
+		ReturnValue: hexutil.Bytes{0x01},
+		Logs:        []*ethtypes.Log{},
+		GasUsed:     21000,
+		Status:      1,
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		opts         rpctypes.SimOpts
+		expBlocks    func(header *tmrpctypes.ResultBlock) []evmtypes.SimBlock
+		expPass      bool
+	}{
+		{
+			"fail - empty input",
+			func() {},
+			rpctypes.SimOpts{},
+			nil,
+			false,
+		},
+		{
+			"fail - validation is not supported",
+			func() {},
+			rpctypes.SimOpts{BlockStateCalls: []evmtypes.SimBlock{{}}, Validation: true},
+			nil,
+			false,
+		},
+		{
+			"pass - first block number and timestamp defaults",
+			func() {},
+			rpctypes.SimOpts{BlockStateCalls: []evmtypes.SimBlock{
+				{Calls: []evmtypes.TransactionArgs{callArgs}},
+				{BlockOverrides: &evmtypes.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(10))}},
+			}},
+			func(header *tmrpctypes.ResultBlock) []evmtypes.SimBlock {
+				time := header.Block.Time.Unix()
+				return []evmtypes.SimBlock{
+					{
+						BlockOverrides: &evmtypes.BlockOverrides{
+							Number: (*hexutil.Big)(big.NewInt(2)),
+							Time:   (*hexutil.Big)(big.NewInt(time + evmtypes.SimBlockTimeIncrement)),
+						},
+						Calls: []evmtypes.TransactionArgs{callArgs},
+					},
+					// the following blocks are completed by the keeper
+					{
+						BlockOverrides: &evmtypes.BlockOverrides{
+							Number: (*hexutil.Big)(big.NewInt(10)),
+						},
+					},
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			var expResults []evmtypes.SimBlockResult
+			if tc.expBlocks != nil {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				header, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+
+				blocks := tc.expBlocks(header)
+				for _, block := range blocks {
+					expResults = append(expResults, evmtypes.SimBlockResult{
+						Number: block.BlockOverrides.Number,
+						Calls:  []evmtypes.SimCallResult{callResult},
+					})
+				}
+				blocksBz, err := json.Marshal(blocks)
+				suite.Require().NoError(err)
+				data, err := json.Marshal(expResults)
+				suite.Require().NoError(err)
+				RegisterSimulateCalls(queryClient, &evmtypes.QuerySimulateCallsRequest{
+					Blocks:  blocksBz,
+					ChainId: suite.backend.chainID.Int64(),
+				}, data)
+			}
+
+			results, err := suite.backend.SimulateV1(tc.opts, rpctypes.BlockNumber(1))
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expResults, results)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestCallMany() {
+	_, bz := suite.buildEthereumTx()
+	toAddr := tests.GenerateAddress()
+	callArgs := []evmtypes.TransactionArgs{{To: &toAddr}, {To: &toAddr}}
+	nonce := hexutil.Uint64(1)
+	overrides := rpctypes.StateOverride{toAddr: {Nonce: &nonce}}
+	callResults := []evmtypes.SimCallResult{
+		{ReturnValue: hexutil.Bytes{0x01}, Logs: []*ethtypes.Log{}, GasUsed: 21000, Status: 1},
+		{ReturnValue: hexutil.Bytes{}, Logs: []*ethtypes.Log{}, GasUsed: 21000, Status: 0, Error: &evmtypes.SimCallError{
+			Code:    3,
+			Message: "execution reverted",
+		}},
+	}
+
+	client := suite.backend.clientCtx.Client.(*mocks.Client)
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+	RegisterBlock(client, 1, bz)
+	blocksBz, err := json.Marshal([]evmtypes.SimBlock{{StateOverrides: &overrides, Calls: callArgs}})
+	suite.Require().NoError(err)
+	data, err := json.Marshal([]evmtypes.SimBlockResult{{Number: (*hexutil.Big)(big.NewInt(1)), Calls: callResults}})
+	suite.Require().NoError(err)
+	RegisterSimulateCalls(queryClient, &evmtypes.QuerySimulateCallsRequest{
+		Blocks:  blocksBz,
+		ChainId: suite.backend.chainID.Int64(),
+	}, data)
+
+	results, err := suite.backend.CallMany(callArgs, rpctypes.BlockNumber(1), &overrides)
+	suite.Require().NoError(err)
+	suite.Require().Equal(callResults, results)
+}
+
+func (suite *BackendTestSuite) TestCreateAccessList() {
+	_, bz := suite.buildEthereumTx()
+	toAddr := tests.GenerateAddress()
+	callArgs := evmtypes.TransactionArgs{To: &toAddr}
+	argsBz, err := json.Marshal(callArgs)
+	suite.Require().NoError(err)
+	request := &evmtypes.EthCallRequest{Args: argsBz, ChainId: suite.backend.chainID.Int64()}
+	accessList := ethtypes.AccessList{{Address: toAddr, StorageKeys: []common.Hash{{0x01}}}}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expResult    *rpctypes.AccessListResult
+		expPass      bool
+	}{
+		{
+			"fail - header not found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - invalid request",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterBlock(client, 1, bz)
+				RegisterCreateAccessListError(queryClient, request)
+			},
+			nil,
+			false,
+		},
+		{
+			"pass - empty access list",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterBlock(client, 1, bz)
+				RegisterCreateAccessList(queryClient, request, &evmtypes.QueryCreateAccessListResponse{
+					GasUsed: 21000,
+					VmError: "execution reverted",
+				})
+			},
+			&rpctypes.AccessListResult{
+				Accesslist: &ethtypes.AccessList{},
+				Error:      "execution reverted",
+				GasUsed:    21000,
+			},
+			true,
+		},
+		{
+			"pass - returned access list",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterBlock(client, 1, bz)
+				RegisterCreateAccessList(queryClient, request, &evmtypes.QueryCreateAccessListResponse{
+					AccessList: evmtypes.NewAccessList(&accessList),
+					GasUsed:    23100,
+				})
+			},
+			&rpctypes.AccessListResult{
+				Accesslist: &accessList,
+				GasUsed:    23100,
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			result, err := suite.backend.CreateAccessList(callArgs, rpctypes.BlockNumber(1))
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResult, result)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestGasPrice() {
 	defaultGasPrice := (*hexutil.Big)(big.NewInt(1))
 
@@ -110,16 +110,22 @@ func (b *Backend) CurrentHeader() *ethtypes.Header {
 }
 
 // PendingTransactions returns the transactions that are in the transaction pool
-// and have a from address that is one of the accounts this node manages.
+// and have a from address that is one of the accounts this node manages. At most
+// `maxUnconfirmedTxs` transactions are returned, in mempool order.
 func (b *Backend) PendingTransactions() ([]*sdk.Tx, error) {
 	mc, ok := b.clientCtx.Client.(tmrpcclient.MempoolClient)
 	if !ok {
 		b.logger.Error("invalid rpc client")
 	}
-	res, err := mc.UnconfirmedTxs(b.ctx, nil)
+	// a nil limit only returns the default page of the mempool
+	limit := maxUnconfirmedTxs
+	res, err := mc.UnconfirmedTxs(b.ctx, &limit)
 	if err != nil {
 		return nil, err
 	}
+	if res.Total > res.Count {
+		b.logger.Debug("mempool content truncated", "returned", res.Count, "total", res.Total)
+	}
 
 	result := make([]*sdk.Tx, 0, len(res.Txs))
 	for _, txBz := range res.Txs {
@@ -2,6 +2,7 @@ package backend
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -10,6 +11,7 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/libs/bytes"
+	"github.com/cometbft/cometbft/proto/tendermint/crypto"
 	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	"github.com/cometbft/cometbft/types"
@@ -58,29 +60,54 @@ func RegisterBroadcastTxError(client *mocks.Client, tx types.Tx) {
 }
 
 // Unconfirmed Transactions
-func RegisterUnconfirmedTxs(client *mocks.Client, limit *int, txs []types.Tx) {
-	client.On("UnconfirmedTxs", rpc.ContextWithHeight(1), limit).
-		Return(&tmrpctypes.ResultUnconfirmedTxs{Txs: txs}, nil)
+func RegisterUnconfirmedTxs(client *mocks.Client, txs []types.Tx) {
+	limit := maxUnconfirmedTxs
+	client.On("UnconfirmedTxs", rpc.ContextWithHeight(1), &limit).
+		Return(&tmrpctypes.ResultUnconfirmedTxs{Count: len(txs), Total: len(txs), Txs: txs}, nil)
 }
 
-func RegisterUnconfirmedTxsEmpty(client *mocks.Client, limit *int) {
-	client.On("UnconfirmedTxs", rpc.ContextWithHeight(1), limit).
+func RegisterUnconfirmedTxsEmpty(client *mocks.Client) {
+	limit := maxUnconfirmedTxs
+	client.On("UnconfirmedTxs", rpc.ContextWithHeight(1), &limit).
 		Return(&tmrpctypes.ResultUnconfirmedTxs{
 			Txs: make([]types.Tx, 2),
 		}, nil)
 }
 
-func RegisterUnconfirmedTxsError(client *mocks.Client, limit *int) {
-	client.On("UnconfirmedTxs", rpc.ContextWithHeight(1), limit).
+func RegisterUnconfirmedTxsError(client *mocks.Client) {
+	limit := maxUnconfirmedTxs
+	client.On("UnconfirmedTxs", rpc.ContextWithHeight(1), &limit).
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+func RegisterNumUnconfirmedTxs(client *mocks.Client, total int) {
+	client.On("NumUnconfirmedTxs", rpc.ContextWithHeight(1)).
+		Return(&tmrpctypes.ResultUnconfirmedTxs{Count: total, Total: total}, nil)
+}
+
 // Status
 func RegisterStatus(client *mocks.Client) {
 	client.On("Status", rpc.ContextWithHeight(1)).
 		Return(&tmrpctypes.ResultStatus{}, nil)
 }
 
+func RegisterDumpConsensusState(client *mocks.Client, peerHeights ...int64) {
+	peers := make([]tmrpctypes.PeerStateInfo, len(peerHeights))
+	for i, height := range peerHeights {
+		peers[i] = tmrpctypes.PeerStateInfo{
+			NodeAddress: fmt.Sprintf("peer%d", i),
+			PeerState:   []byte(fmt.Sprintf(`{"round_state":{"height":"%d"}}`, height)),
+		}
+	}
+	client.On("DumpConsensusState", rpc.ContextWithHeight(1)).
+		Return(&tmrpctypes.ResultDumpConsensusState{Peers: peers}, nil)
+}
+
+func RegisterDumpConsensusStateError(client *mocks.Client) {
+	client.On("DumpConsensusState", rpc.ContextWithHeight(1)).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
 func RegisterStatusError(client *mocks.Client) {
 	client.On("Status", rpc.ContextWithHeight(1)).
 		Return(nil, errortypes.ErrInvalidRequest)
@@ -263,6 +290,17 @@ func RegisterABCIQueryWithOptions(client *mocks.Client, height int64, path strin
 		}, nil)
 }
 
+func RegisterABCIQueryWithProof(client *mocks.Client, height int64, path string, data bytes.HexBytes, opts tmrpcclient.ABCIQueryOptions, proof *crypto.ProofOps) {
+	client.On("ABCIQueryWithOptions", context.Background(), path, data, opts).
+		Return(&tmrpctypes.ResultABCIQuery{
+			Response: abci.ResponseQuery{
+				Value:    []byte{2},
+				ProofOps: proof,
+				Height:   height,
+			},
+		}, nil)
+}
+
 func RegisterABCIQueryWithOptionsError(clients *mocks.Client, path string, data bytes.HexBytes, opts tmrpcclient.ABCIQueryOptions) {
 	clients.On("ABCIQueryWithOptions", context.Background(), path, data, opts).
 		Return(nil, errortypes.ErrInvalidRequest)
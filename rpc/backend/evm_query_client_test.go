@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"strconv"
 	"testing"
 
@@ -50,6 +51,17 @@ func RegisterTraceTransactionError(queryClient *mocks.EVMQueryClient, msgEthTx *
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+// TraceCall
+func RegisterTraceCall(queryClient *mocks.EVMQueryClient, request *evmtypes.QueryTraceCallRequest) {
+	queryClient.On("TraceCall", rpc.ContextWithHeight(1), request).
+		Return(&evmtypes.QueryTraceTxResponse{Data: data}, nil)
+}
+
+func RegisterTraceCallError(queryClient *mocks.EVMQueryClient, request *evmtypes.QueryTraceCallRequest) {
+	queryClient.On("TraceCall", rpc.ContextWithHeight(1), request).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
 // TraceBlock
 func RegisterTraceBlock(queryClient *mocks.EVMQueryClient, txs []*evmtypes.MsgEthereumTx) {
 	data := []byte{0x7b, 0x22, 0x74, 0x65, 0x73, 0x74, 0x22, 0x3a, 0x20, 0x22, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x22, 0x7d}
@@ -58,11 +70,101 @@ func RegisterTraceBlock(queryClient *mocks.EVMQueryClient, txs []*evmtypes.MsgEt
 		Return(&evmtypes.QueryTraceBlockResponse{Data: data}, nil)
 }
 
+func RegisterTraceBlockChunk(
+	queryClient *mocks.EVMQueryClient,
+	state *evmtypes.TraceBlockState,
+	txs []*evmtypes.MsgEthereumTx,
+	results []*evmtypes.TxTraceResult,
+	nextState *evmtypes.TraceBlockState,
+) {
+	data, _ := json.Marshal(results)
+	queryClient.On("TraceBlock", rpc.ContextWithHeight(1),
+		&evmtypes.QueryTraceBlockRequest{Txs: txs, BlockNumber: 1, TraceConfig: &evmtypes.TraceConfig{}, ChainId: 9000, State: state}).
+		Return(&evmtypes.QueryTraceBlockResponse{Data: data, State: nextState}, nil)
+}
+
+// traceBlockStreamClient streams the responses of a TraceBlockStream query, then err or the
+// end of the stream.
+type traceBlockStreamClient struct {
+	grpc.ClientStream
+	responses []*evmtypes.QueryTraceBlockStreamResponse
+	err       error
+}
+
+func (c *traceBlockStreamClient) Recv() (*evmtypes.QueryTraceBlockStreamResponse, error) {
+	if len(c.responses) == 0 {
+		if c.err != nil {
+			return nil, c.err
+		}
+		return nil, io.EOF
+	}
+	res := c.responses[0]
+	c.responses = c.responses[1:]
+	return res, nil
+}
+
+func RegisterTraceBlockStream(
+	queryClient *mocks.EVMQueryClient,
+	txs []*evmtypes.MsgEthereumTx,
+	results []*evmtypes.TxTraceResult,
+	err error,
+) {
+	stream := &traceBlockStreamClient{err: err}
+	for i, result := range results {
+		data, _ := json.Marshal(result)
+		stream.responses = append(stream.responses, &evmtypes.QueryTraceBlockStreamResponse{TxIndex: uint64(i), Data: data})
+	}
+	queryClient.On("TraceBlockStream", mock.Anything,
+		&evmtypes.QueryTraceBlockRequest{Txs: txs, BlockNumber: 1, TraceConfig: &evmtypes.TraceConfig{}, ChainId: 9000}).
+		Return(stream, nil)
+}
+
 func RegisterTraceBlockError(queryClient *mocks.EVMQueryClient) {
 	queryClient.On("TraceBlock", rpc.ContextWithHeight(1), &evmtypes.QueryTraceBlockRequest{}).
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+// IntermediateRoots
+func RegisterIntermediateRoots(queryClient *mocks.EVMQueryClient, txs []*evmtypes.MsgEthereumTx, roots [][]byte) {
+	queryClient.On("IntermediateRoots", rpc.ContextWithHeight(1),
+		&evmtypes.QueryTraceBlockRequest{Txs: txs, BlockNumber: 1, ChainId: 9000}).
+		Return(&evmtypes.QueryIntermediateRootsResponse{Roots: roots}, nil)
+}
+
+func RegisterIntermediateRootsReplayError(queryClient *mocks.EVMQueryClient, txs []*evmtypes.MsgEthereumTx, roots [][]byte, txErrors []string) {
+	queryClient.On("IntermediateRoots", rpc.ContextWithHeight(1),
+		&evmtypes.QueryTraceBlockRequest{Txs: txs, BlockNumber: 1, ChainId: 9000}).
+		Return(&evmtypes.QueryIntermediateRootsResponse{Roots: roots, Errors: txErrors}, nil)
+}
+
+func RegisterIntermediateRootsError(queryClient *mocks.EVMQueryClient, txs []*evmtypes.MsgEthereumTx) {
+	queryClient.On("IntermediateRoots", rpc.ContextWithHeight(1),
+		&evmtypes.QueryTraceBlockRequest{Txs: txs, BlockNumber: 1, ChainId: 9000}).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
+// StorageRangeAt
+func RegisterStorageRangeAt(queryClient *mocks.EVMQueryClient, storage []evmtypes.State, nextKey []byte) {
+	queryClient.On("StorageRangeAt", rpc.ContextWithHeight(1), mock.AnythingOfType("*types.QueryStorageRangeAtRequest")).
+		Return(&evmtypes.QueryStorageRangeAtResponse{Storage: storage, NextKey: nextKey}, nil)
+}
+
+func RegisterStorageRangeAtError(queryClient *mocks.EVMQueryClient) {
+	queryClient.On("StorageRangeAt", rpc.ContextWithHeight(1), mock.AnythingOfType("*types.QueryStorageRangeAtRequest")).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
+// AccountRange
+func RegisterAccountRange(queryClient *mocks.EVMQueryClient, req *evmtypes.QueryAccountRangeRequest, accounts []evmtypes.AccountDump, next []byte) {
+	queryClient.On("AccountRange", rpc.ContextWithHeight(1), req).
+		Return(&evmtypes.QueryAccountRangeResponse{Accounts: accounts, Next: next}, nil)
+}
+
+func RegisterAccountRangeError(queryClient *mocks.EVMQueryClient, req *evmtypes.QueryAccountRangeRequest) {
+	queryClient.On("AccountRange", rpc.ContextWithHeight(1), req).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
 // Params
 func RegisterParams(queryClient *mocks.EVMQueryClient, header *metadata.MD, height int64) {
 	queryClient.On("Params", rpc.ContextWithHeight(height), &evmtypes.QueryParamsRequest{}, grpc.Header(header)).
@@ -150,6 +252,33 @@ func RegisterEthCallError(queryClient *mocks.EVMQueryClient, request *evmtypes.E
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+// matchContextHeight matches the contexts derived from rpc.ContextWithHeight(height),
+// eg. with a cancel function or a timeout
+func matchContextHeight(height int64) interface{} {
+	return mock.MatchedBy(func(ctx context.Context) bool {
+		md, ok := metadata.FromOutgoingContext(ctx)
+		return ok && len(md.Get(grpctypes.GRPCBlockHeightHeader)) == 1 &&
+			md.Get(grpctypes.GRPCBlockHeightHeader)[0] == strconv.FormatInt(height, 10)
+	})
+}
+
+// Create Access List
+func RegisterCreateAccessList(queryClient *mocks.EVMQueryClient, request *evmtypes.EthCallRequest, response *evmtypes.QueryCreateAccessListResponse) {
+	queryClient.On("CreateAccessList", matchContextHeight(1), request).
+		Return(response, nil)
+}
+
+func RegisterCreateAccessListError(queryClient *mocks.EVMQueryClient, request *evmtypes.EthCallRequest) {
+	queryClient.On("CreateAccessList", matchContextHeight(1), request).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
+// Simulate Calls
+func RegisterSimulateCalls(queryClient *mocks.EVMQueryClient, request *evmtypes.QuerySimulateCallsRequest, data []byte) {
+	queryClient.On("SimulateCalls", matchContextHeight(1), request).
+		Return(&evmtypes.QuerySimulateCallsResponse{Data: data}, nil)
+}
+
 // Estimate Gas
 func RegisterEstimateGas(queryClient *mocks.EVMQueryClient, args evmtypes.TransactionArgs) {
 	bz, _ := json.Marshal(args)
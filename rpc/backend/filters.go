@@ -19,6 +19,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
+
+	ethermint "github.com/evmos/ethermint/types"
 )
 
 // GetLogs returns all the logs from all the ethereum transactions in a block.
@@ -44,6 +46,38 @@ func (b *Backend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
 	return GetLogsFromBlockResults(blockRes)
 }
 
+// GetLogsFromIndex returns the logs emitted by one of the addresses and matching the
+// topic rules within the longest prefix of the block range whose logs are indexed by
+// the persistent log index of the indexer, together with the last block of the prefix.
+// At most limit+1 logs are returned, so that the caller can tell the limit is exceeded.
+// The prefix is empty, ending at from-1, if the indexer is disabled or the first block
+// isn't indexed yet.
+func (b *Backend) GetLogsFromIndex(
+	from, to int64,
+	addresses []common.Address,
+	topics [][]common.Hash,
+	limit int,
+) ([]*ethtypes.Log, int64, error) {
+	logIndexer, ok := b.indexer.(ethermint.EVMLogIndexer)
+	if !ok {
+		return nil, from - 1, nil
+	}
+
+	until, err := logIndexer.LogIndexedUntil(from, to)
+	if err != nil {
+		return nil, from - 1, err
+	}
+	if until < from {
+		return nil, from - 1, nil
+	}
+
+	logs, err := logIndexer.GetLogs(from, until, addresses, topics, limit)
+	if err != nil {
+		return nil, from - 1, err
+	}
+	return logs, until, nil
+}
+
 // BloomStatus returns the BloomBitsBlocks and the number of processed sections maintained
 // by the chain indexer.
 func (b *Backend) BloomStatus() (uint64, uint64) {
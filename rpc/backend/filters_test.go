@@ -3,9 +3,13 @@ package backend
 import (
 	"encoding/json"
 
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmlog "github.com/cometbft/cometbft/libs/log"
 	tmtypes "github.com/cometbft/cometbft/types"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/ethermint/indexer"
 	"github.com/evmos/ethermint/rpc/backend/mocks"
 	ethrpc "github.com/evmos/ethermint/rpc/types"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
@@ -87,6 +91,123 @@ func (suite *BackendTestSuite) TestGetLogs() {
 	}
 }
 
+func (suite *BackendTestSuite) TestGetLogsFromIndex() {
+	address := common.BigToAddress(common.Big1)
+	topic := common.BigToHash(common.Big2)
+	ethLogs := []*ethtypes.Log{
+		{Address: address, Topics: []common.Hash{topic}, BlockNumber: 1, Index: 0},
+		{Address: common.BigToAddress(common.Big2), Topics: []common.Hash{topic}, BlockNumber: 1, Index: 1},
+		{Address: address, Topics: []common.Hash{}, BlockNumber: 2, Index: 0},
+	}
+
+	indexBlocks := func() {
+		db := dbm.NewMemDB()
+		suite.backend.indexer = indexer.NewKVIndexer(db, tmlog.NewNopLogger(), suite.backend.clientCtx)
+		for height := int64(1); height <= 2; height++ {
+			var attrs []abci.EventAttribute
+			for _, log := range ethLogs {
+				if log.BlockNumber != uint64(height) {
+					continue
+				}
+				bz, err := json.Marshal(evmtypes.NewLogFromEth(log))
+				suite.Require().NoError(err)
+				attrs = append(attrs, abci.EventAttribute{Key: evmtypes.AttributeKeyTxLog, Value: string(bz)})
+			}
+			err := suite.backend.indexer.IndexBlock(
+				&tmtypes.Block{Header: tmtypes.Header{Height: height}},
+				[]*abci.ResponseDeliverTx{{Events: []abci.Event{{Type: evmtypes.EventTypeTxLog, Attributes: attrs}}}},
+			)
+			suite.Require().NoError(err)
+		}
+	}
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		from, to  int64
+		addresses []common.Address
+		topics    [][]common.Hash
+		expUntil  int64
+		expLogs   []*ethtypes.Log
+	}{
+		{
+			"pass - indexer disabled",
+			func() {
+				suite.backend.indexer = nil
+			},
+			1, 2, nil, nil,
+			0,
+			nil,
+		},
+		{
+			"pass - first block not indexed",
+			indexBlocks,
+			3, 4, nil, nil,
+			2,
+			nil,
+		},
+		{
+			"pass - indexed prefix of the range",
+			indexBlocks,
+			1, 3, nil, nil,
+			2,
+			ethLogs,
+		},
+		{
+			"pass - indexed prefix ends at a gap",
+			func() {
+				indexBlocks()
+				err := suite.backend.indexer.IndexBlock(&tmtypes.Block{Header: tmtypes.Header{Height: 4}}, nil)
+				suite.Require().NoError(err)
+			},
+			1, 4, nil, nil,
+			2,
+			ethLogs,
+		},
+		{
+			"pass - all logs",
+			indexBlocks,
+			1, 2, nil, nil,
+			2,
+			ethLogs,
+		},
+		{
+			"pass - logs by address",
+			indexBlocks,
+			1, 2, []common.Address{address}, nil,
+			2,
+			[]*ethtypes.Log{ethLogs[0], ethLogs[2]},
+		},
+		{
+			"pass - logs by first topic",
+			indexBlocks,
+			1, 2, nil, [][]common.Hash{{topic}},
+			2,
+			[]*ethtypes.Log{ethLogs[0], ethLogs[1]},
+		},
+		{
+			"pass - logs by address and topic",
+			indexBlocks,
+			1, 2, []common.Address{address}, [][]common.Hash{{topic}},
+			2,
+			[]*ethtypes.Log{ethLogs[0]},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			tc.malleate()
+			logs, until, err := suite.backend.GetLogsFromIndex(tc.from, tc.to, tc.addresses, tc.topics, 10)
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expUntil, until)
+			suite.Require().Equal(tc.expLogs, logs)
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestBloomStatus() {
 	testCases := []struct {
 		name         string
@@ -16,12 +16,14 @@
 package backend
 
 import (
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
+	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
 	tmtypes "github.com/cometbft/cometbft/types"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
@@ -82,12 +84,46 @@ func (b *Backend) Syncing() (interface{}, error) {
 	return map[string]interface{}{
 		"startingBlock": hexutil.Uint64(status.SyncInfo.EarliestBlockHeight),
 		"currentBlock":  hexutil.Uint64(status.SyncInfo.LatestBlockHeight),
-		// "highestBlock":  nil, // NA
+		"highestBlock":  hexutil.Uint64(b.highestPeerBlock(status.SyncInfo.LatestBlockHeight)),
 		// "pulledStates":  nil, // NA
 		// "knownStates":   nil, // NA
 	}, nil
 }
 
+// highestPeerBlock returns the highest block known to be committed by the peers, which is
+// one below the height their consensus is working on. It falls back to the latest block of
+// this node if the peer states are not available or behind.
+func (b *Backend) highestPeerBlock(latest int64) int64 {
+	highest := latest
+
+	nc, ok := b.clientCtx.Client.(tmrpcclient.NetworkClient)
+	if !ok {
+		b.logger.Debug("invalid rpc client")
+		return highest
+	}
+	res, err := nc.DumpConsensusState(b.ctx)
+	if err != nil {
+		b.logger.Debug("failed to dump consensus state", "error", err.Error())
+		return highest
+	}
+
+	for _, peer := range res.Peers {
+		var state struct {
+			RoundState struct {
+				Height int64 `json:"height,string"`
+			} `json:"round_state"`
+		}
+		if err := json.Unmarshal(peer.PeerState, &state); err != nil {
+			b.logger.Debug("failed to decode peer state", "peer", peer.NodeAddress, "error", err.Error())
+			continue
+		}
+		if state.RoundState.Height-1 > highest {
+			highest = state.RoundState.Height - 1
+		}
+	}
+	return highest
+}
+
 // SetEtherbase sets the etherbase of the miner
 func (b *Backend) SetEtherbase(etherbase common.Address) bool {
 	delAddr, err := b.GetCoinbase()
@@ -335,6 +371,12 @@ func (b *Backend) RPCBlockRangeCap() int32 {
 	return b.cfg.JSONRPC.BlockRangeCap
 }
 
+// RPCTraceBlockChunkSize defines the max number of transactions traced by a single query
+// when tracing a block.
+func (b *Backend) RPCTraceBlockChunkSize() int32 {
+	return b.cfg.JSONRPC.TraceBlockChunkSize
+}
+
 // RPCMinGasPrice returns the minimum gas price for a transaction obtained from
 // the node config. If set value is 0, it will default to 20.
 
@@ -191,10 +191,30 @@ func (suite *BackendTestSuite) TestSyncing() {
 				RegisterStatus(client)
 				status, _ := client.Status(suite.backend.ctx)
 				status.SyncInfo.CatchingUp = true
+				RegisterDumpConsensusStateError(client)
 			},
 			map[string]interface{}{
 				"startingBlock": hexutil.Uint64(0),
 				"currentBlock":  hexutil.Uint64(0),
+				"highestBlock":  hexutil.Uint64(0),
+			},
+			true,
+		},
+		{
+			"pass - Node is catching up with highest block from peers",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterStatus(client)
+				status, _ := client.Status(suite.backend.ctx)
+				status.SyncInfo.CatchingUp = true
+				status.SyncInfo.EarliestBlockHeight = 1
+				status.SyncInfo.LatestBlockHeight = 5
+				RegisterDumpConsensusState(client, 3, 11, 8)
+			},
+			map[string]interface{}{
+				"startingBlock": hexutil.Uint64(1),
+				"currentBlock":  hexutil.Uint64(5),
+				"highestBlock":  hexutil.Uint64(10),
 			},
 			true,
 		},
@@ -16,16 +16,21 @@
 package backend
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"math"
 
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	rpctypes "github.com/evmos/ethermint/rpc/types"
+	evmtracers "github.com/evmos/ethermint/x/evm/tracers"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // TraceTransaction returns the structured logs created during the execution of EVM
@@ -173,6 +178,70 @@ func (b *Backend) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfi
 	return jsonResult, nil
 }
 
+// TraceCall configures a new tracer according to the provided configuration, and
+// executes the call on top of the given block, with the state and block overrides of
+// the config applied. The return value is dependent on the requested tracer.
+func (b *Backend) TraceCall(
+	args evmtypes.TransactionArgs,
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	config *rpctypes.TraceCallConfig,
+) (interface{}, error) {
+	blockNr, err := b.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	blk, err := b.TendermintBlockByNumber(blockNr)
+	if err != nil {
+		b.logger.Debug("block not found", "height", blockNr)
+		return nil, err
+	}
+	if blk == nil || blk.Block == nil {
+		return nil, fmt.Errorf("block not found for height %d", blockNr)
+	}
+
+	bz, err := json.Marshal(&args)
+	if err != nil {
+		return nil, err
+	}
+
+	traceCallRequest := evmtypes.QueryTraceCallRequest{
+		Args:            bz,
+		GasCap:          b.RPCGasCap(),
+		BlockNumber:     blk.Block.Height,
+		BlockTime:       blk.Block.Time,
+		BlockHash:       common.Bytes2Hex(blk.BlockID.Hash),
+		ProposerAddress: sdk.ConsAddress(blk.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+	}
+
+	if config != nil {
+		traceCallRequest.TraceConfig = &config.TraceConfig
+		if config.StateOverrides != nil {
+			if traceCallRequest.Overrides, err = json.Marshal(config.StateOverrides); err != nil {
+				return nil, err
+			}
+		}
+		if config.BlockOverrides != nil {
+			if traceCallRequest.BlockOverrides, err = json.Marshal(config.BlockOverrides); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	traceResult, err := b.queryClient.TraceCall(rpctypes.ContextWithHeight(blk.Block.Height), &traceCallRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response format is unknown due to custom tracer config param
+	var decodedResult interface{}
+	if err := json.Unmarshal(traceResult.Data, &decodedResult); err != nil {
+		return nil, err
+	}
+	return decodedResult, nil
+}
+
 // TraceBlock configures a new tracer according to the provided configuration, and
 // executes all the transactions contained within. The return value will be one item
 // per transaction, dependent on the requested tracer.
@@ -180,14 +249,248 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 	config *evmtypes.TraceConfig,
 	block *tmrpctypes.ResultBlock,
 ) ([]*evmtypes.TxTraceResult, error) {
-	txs := block.Block.Txs
-	txsLength := len(txs)
+	results := []*evmtypes.TxTraceResult{}
+	err := b.TraceBlockStream(height, config, block, func(result *evmtypes.TxTraceResult) error {
+		results = append(results, result)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// TraceBlockStream traces the block like TraceBlock and passes the result of each transaction
+// to fn in order as soon as it's traced, it stops at the first error of fn. The block is
+// traced by a single streaming query if the gRPC server is enabled, and in chunks otherwise.
+func (b *Backend) TraceBlockStream(height rpctypes.BlockNumber,
+	config *evmtypes.TraceConfig,
+	block *tmrpctypes.ResultBlock,
+	fn func(result *evmtypes.TxTraceResult) error,
+) error {
+	if len(block.Block.Txs) == 0 {
+		return nil
+	}
+
+	ctxWithHeight, traceBlockRequest := b.traceBlockRequest(height, config, block)
+	return b.traceBlock(ctxWithHeight, traceBlockRequest, fn)
+}
+
+// traceBlock traces the txs of the request like TraceBlockStream, the txs of the request
+// are changed if the block is traced in chunks.
+func (b *Backend) traceBlock(
+	ctx context.Context,
+	req *evmtypes.QueryTraceBlockRequest,
+	fn func(result *evmtypes.TxTraceResult) error,
+) error {
+	if b.queryClient.Stream != nil {
+		streamed, err := b.traceBlockStream(ctx, req, fn)
+		if status.Code(err) != codes.Unimplemented || streamed > 0 {
+			return err
+		}
+		// the node doesn't serve the streaming queries
+	}
+	return b.traceBlockChunks(ctx, req, fn)
+}
+
+// traceBlockStream traces the block with a streaming query, it returns the number of results
+// passed to fn.
+func (b *Backend) traceBlockStream(
+	ctx context.Context,
+	req *evmtypes.QueryTraceBlockRequest,
+	fn func(result *evmtypes.TxTraceResult) error,
+) (int, error) {
+	// the query is canceled if fn fails
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := b.queryClient.Stream.TraceBlockStream(ctx, req)
+	if err != nil {
+		return 0, err
+	}
+
+	for streamed := 0; ; streamed++ {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			if streamed != len(req.Txs) {
+				return streamed, fmt.Errorf("%d results streamed for %d txs", streamed, len(req.Txs))
+			}
+			return streamed, nil
+		}
+		if err != nil {
+			return streamed, err
+		}
+
+		var result evmtypes.TxTraceResult
+		if err := json.Unmarshal(res.Data, &result); err != nil {
+			return streamed, err
+		}
+		if err := fn(&result); err != nil {
+			return streamed, err
+		}
+	}
+}
 
-	if txsLength == 0 {
-		// If there are no transactions return empty array
-		return []*evmtypes.TxTraceResult{}, nil
+// traceBlockChunks traces the block in chunks of txs to bound the size of the query responses,
+// the state written by the txs before a chunk is carried from the previous chunk.
+func (b *Backend) traceBlockChunks(
+	ctx context.Context,
+	req *evmtypes.QueryTraceBlockRequest,
+	fn func(result *evmtypes.TxTraceResult) error,
+) error {
+	msgs := req.Txs
+	chunkSize := int(b.RPCTraceBlockChunkSize())
+	if chunkSize <= 0 || chunkSize >= len(msgs) {
+		chunkSize = len(msgs)
+	} else {
+		req.State = &evmtypes.TraceBlockState{}
 	}
 
+	for start := 0; start < len(msgs); start += chunkSize {
+		end := start + chunkSize
+		if end > len(msgs) {
+			end = len(msgs)
+		}
+		req.Txs = msgs[start:end]
+
+		results, state, err := b.traceBlockChunk(ctx, req)
+		if err != nil {
+			return err
+		}
+		if req.State != nil && state == nil {
+			return fmt.Errorf("the state after the txs %d to %d is missing", start, end)
+		}
+		for _, result := range results {
+			if err := fn(result); err != nil {
+				return err
+			}
+		}
+		req.State = state
+	}
+	return nil
+}
+
+// InternalTxAddresses traces the eth txs of the block with the internal operations tracer
+// and returns, by tx hash, the addresses taking part in their internal transactions: the
+// senders and recipients of the value transfers, the created contracts and the self
+// destructed ones with their beneficiaries. The txs failing to be traced are logged and
+// have no addresses.
+func (b *Backend) InternalTxAddresses(height int64) (map[common.Hash][]common.Address, error) {
+	blockNr := rpctypes.BlockNumber(height)
+	block, err := b.TendermintBlockByNumber(blockNr)
+	if err != nil {
+		return nil, err
+	}
+	if block == nil || block.Block == nil {
+		return nil, fmt.Errorf("block %d not found", height)
+	}
+
+	config := &evmtypes.TraceConfig{Tracer: evmtracers.OtsInternalOperationsTracerName}
+	ctxWithHeight, traceBlockRequest := b.traceBlockRequest(blockNr, config, block)
+	txs := traceBlockRequest.Txs
+	if len(txs) == 0 {
+		return map[common.Hash][]common.Address{}, nil
+	}
+
+	addresses := make(map[common.Hash][]common.Address)
+	traced := 0
+	err = b.traceBlock(ctxWithHeight, traceBlockRequest, func(result *evmtypes.TxTraceResult) error {
+		if traced == len(txs) {
+			return fmt.Errorf("more trace results than the %d txs of block %d", len(txs), height)
+		}
+		hash := common.HexToHash(txs[traced].Hash)
+		traced++
+		if result.Error != "" {
+			// the addresses of the other txs are still indexed
+			b.logger.Error("failed to trace the internal txs", "hash", hash.Hex(), "error", result.Error)
+			return nil
+		}
+		return addOperationAddresses(addresses, hash, result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if traced != len(txs) {
+		return nil, fmt.Errorf("%d trace results for the %d txs of block %d", traced, len(txs), height)
+	}
+	return addresses, nil
+}
+
+// addOperationAddresses adds the addresses taking part in the internal operations of the trace
+// result of the tx to the addresses by tx hash.
+func addOperationAddresses(addresses map[common.Hash][]common.Address, hash common.Hash, result *evmtypes.TxTraceResult) error {
+	// the result is decoded as a generic json value
+	bz, err := json.Marshal(result.Result)
+	if err != nil {
+		return err
+	}
+	var operations []*evmtracers.InternalOperation
+	if err := json.Unmarshal(bz, &operations); err != nil {
+		return err
+	}
+
+	seen := make(map[common.Address]bool)
+	for _, op := range operations {
+		for _, address := range []common.Address{op.From, op.To} {
+			if !seen[address] {
+				seen[address] = true
+				addresses[hash] = append(addresses[hash], address)
+			}
+		}
+	}
+	return nil
+}
+
+// traceBlockChunk traces the txs of the request and decodes their trace results, it also
+// returns the block state after the txs if the request carries the state.
+func (b *Backend) traceBlockChunk(
+	ctx context.Context,
+	req *evmtypes.QueryTraceBlockRequest,
+) ([]*evmtypes.TxTraceResult, *evmtypes.TraceBlockState, error) {
+	res, err := b.queryClient.TraceBlock(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	decodedResults := make([]*evmtypes.TxTraceResult, len(req.Txs))
+	if err := json.Unmarshal(res.Data, &decodedResults); err != nil {
+		return nil, nil, err
+	}
+
+	return decodedResults, res.State, nil
+}
+
+// IntermediateRoots re-executes the transactions of the given block and returns the
+// intermediate root after each of them, it fails if any of them can't be replayed.
+func (b *Backend) IntermediateRoots(height rpctypes.BlockNumber, block *tmrpctypes.ResultBlock) ([]common.Hash, error) {
+	if len(block.Block.Txs) == 0 {
+		return []common.Hash{}, nil
+	}
+
+	ctxWithHeight, traceBlockRequest := b.traceBlockRequest(height, nil, block)
+	res, err := b.queryClient.IntermediateRoots(ctxWithHeight, traceBlockRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	roots := make([]common.Hash, 0, len(res.Roots))
+	for i, root := range res.Roots {
+		if i < len(res.Errors) && res.Errors[i] != "" {
+			// the following roots don't match the block anymore
+			return nil, fmt.Errorf("failed to replay transaction %d of block %d: %s", i, height, res.Errors[i])
+		}
+		roots = append(roots, common.BytesToHash(root))
+	}
+	return roots, nil
+}
+
+// traceBlockRequest returns the request to re-execute the ethereum transactions of the
+// given block, along with the context at the beginning of the block.
+func (b *Backend) traceBlockRequest(height rpctypes.BlockNumber,
+	config *evmtypes.TraceConfig,
+	block *tmrpctypes.ResultBlock,
+) (context.Context, *evmtypes.QueryTraceBlockRequest) {
+	txs := block.Block.Txs
 	txDecoder := b.clientCtx.TxConfig.TxDecoder()
 
 	var txsMessages []*evmtypes.MsgEthereumTx
@@ -225,16 +528,5 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 		ProposerAddress: sdk.ConsAddress(block.Block.ProposerAddress),
 		ChainId:         b.chainID.Int64(),
 	}
-
-	res, err := b.queryClient.TraceBlock(ctxWithHeight, traceBlockRequest)
-	if err != nil {
-		return nil, err
-	}
-
-	decodedResults := make([]*evmtypes.TxTraceResult, txsLength)
-	if err := json.Unmarshal(res.Data, &decodedResults); err != nil {
-		return nil, err
-	}
-
-	return decodedResults, nil
+	return ctxWithHeight, traceBlockRequest
 }
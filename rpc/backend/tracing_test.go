@@ -1,8 +1,11 @@
 package backend
 
 import (
+	"encoding/json"
 	"fmt"
+	"math/big"
 
+	sdkmath "cosmossdk.io/math"
 	dbm "github.com/cometbft/cometbft-db"
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmlog "github.com/cometbft/cometbft/libs/log"
@@ -10,11 +13,18 @@ import (
 	tmtypes "github.com/cometbft/cometbft/types"
 	"github.com/cosmos/cosmos-sdk/crypto"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/evmos/ethermint/crypto/ethsecp256k1"
 	"github.com/evmos/ethermint/indexer"
 	"github.com/evmos/ethermint/rpc/backend/mocks"
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+	"github.com/evmos/ethermint/tests"
+	evmtracers "github.com/evmos/ethermint/x/evm/tracers"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (suite *BackendTestSuite) TestTraceTransaction() {
@@ -236,6 +246,15 @@ func (suite *BackendTestSuite) TestTraceBlock() {
 	filledBlock.ChainID = ChainID
 	resBlockEmpty := tmrpctypes.ResultBlock{Block: emptyBlock, BlockID: emptyBlock.LastBlockID}
 	resBlockFilled := tmrpctypes.ResultBlock{Block: filledBlock, BlockID: filledBlock.LastBlockID}
+	multiTxsBlock := tmtypes.MakeBlock(1, []tmtypes.Tx{bz, bz, bz}, nil, nil)
+	multiTxsBlock.ChainID = ChainID
+	resBlockMultiTxs := tmrpctypes.ResultBlock{Block: multiTxsBlock, BlockID: multiTxsBlock.LastBlockID}
+	traceResults := []*evmtypes.TxTraceResult{{Result: "first"}, {Result: "second"}, {Error: "third"}}
+	chunkState := &evmtypes.TraceBlockState{
+		Accounts: []evmtypes.AccountStateDiff{{Address: msgEthTx.From, Exists: true, Nonce: 2, Balance: sdkmath.NewInt(1)}},
+		TxIndex:  2,
+		LogIndex: 1,
+	}
 
 	testCases := []struct {
 		name            string
@@ -264,6 +283,94 @@ func (suite *BackendTestSuite) TestTraceBlock() {
 			&evmtypes.TraceConfig{},
 			false,
 		},
+		{
+			"pass - chunk size larger than the block",
+			func() {
+				suite.backend.cfg.JSONRPC.TraceBlockChunkSize = 5
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterTraceBlockChunk(queryClient, nil, []*evmtypes.MsgEthereumTx{msgEthTx, msgEthTx, msgEthTx}, traceResults, nil)
+			},
+			traceResults,
+			&resBlockMultiTxs,
+			&evmtypes.TraceConfig{},
+			true,
+		},
+		{
+			"pass - block traced in chunks",
+			func() {
+				suite.backend.cfg.JSONRPC.TraceBlockChunkSize = 2
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterTraceBlockChunk(queryClient, &evmtypes.TraceBlockState{}, []*evmtypes.MsgEthereumTx{msgEthTx, msgEthTx}, traceResults[:2], chunkState)
+				RegisterTraceBlockChunk(queryClient, chunkState, []*evmtypes.MsgEthereumTx{msgEthTx}, traceResults[2:], &evmtypes.TraceBlockState{TxIndex: 3})
+			},
+			traceResults,
+			&resBlockMultiTxs,
+			&evmtypes.TraceConfig{},
+			true,
+		},
+		{
+			"pass - block traced by a streaming query",
+			func() {
+				suite.backend.cfg.JSONRPC.TraceBlockChunkSize = 2
+				streamClient := mocks.NewEVMQueryClient(suite.T())
+				suite.backend.queryClient.Stream = streamClient
+				RegisterTraceBlockStream(streamClient, []*evmtypes.MsgEthereumTx{msgEthTx, msgEthTx, msgEthTx}, traceResults, nil)
+			},
+			traceResults,
+			&resBlockMultiTxs,
+			&evmtypes.TraceConfig{},
+			true,
+		},
+		{
+			"pass - streaming query not served by the node",
+			func() {
+				streamClient := mocks.NewEVMQueryClient(suite.T())
+				suite.backend.queryClient.Stream = streamClient
+				RegisterTraceBlockStream(streamClient, []*evmtypes.MsgEthereumTx{msgEthTx, msgEthTx, msgEthTx}, nil, status.Error(codes.Unimplemented, "not supported"))
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterTraceBlockChunk(queryClient, nil, []*evmtypes.MsgEthereumTx{msgEthTx, msgEthTx, msgEthTx}, traceResults, nil)
+			},
+			traceResults,
+			&resBlockMultiTxs,
+			&evmtypes.TraceConfig{},
+			true,
+		},
+		{
+			"fail - streaming query failing after the first tx",
+			func() {
+				streamClient := mocks.NewEVMQueryClient(suite.T())
+				suite.backend.queryClient.Stream = streamClient
+				RegisterTraceBlockStream(streamClient, []*evmtypes.MsgEthereumTx{msgEthTx, msgEthTx, msgEthTx}, traceResults[:1], status.Error(codes.Unimplemented, "not supported"))
+			},
+			nil,
+			&resBlockMultiTxs,
+			&evmtypes.TraceConfig{},
+			false,
+		},
+		{
+			"fail - results missing from the streaming query",
+			func() {
+				streamClient := mocks.NewEVMQueryClient(suite.T())
+				suite.backend.queryClient.Stream = streamClient
+				RegisterTraceBlockStream(streamClient, []*evmtypes.MsgEthereumTx{msgEthTx, msgEthTx, msgEthTx}, traceResults[:2], nil)
+			},
+			nil,
+			&resBlockMultiTxs,
+			&evmtypes.TraceConfig{},
+			false,
+		},
+		{
+			"fail - block state missing from the chunk response",
+			func() {
+				suite.backend.cfg.JSONRPC.TraceBlockChunkSize = 2
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterTraceBlockChunk(queryClient, &evmtypes.TraceBlockState{}, []*evmtypes.MsgEthereumTx{msgEthTx, msgEthTx}, traceResults[:2], nil)
+			},
+			nil,
+			&resBlockMultiTxs,
+			&evmtypes.TraceConfig{},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -282,3 +389,246 @@ func (suite *BackendTestSuite) TestTraceBlock() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestInternalTxAddresses() {
+	msgEthTx, bz := suite.buildEthereumTx()
+	msgEthTx2 := evmtypes.NewTx(suite.backend.chainID, 1, &common.Address{}, big.NewInt(0), 100000, big.NewInt(1), nil, nil, nil, nil)
+	msgEthTx2.From = ""
+	txBuilder := suite.backend.clientCtx.TxConfig.NewTxBuilder()
+	suite.Require().NoError(txBuilder.SetMsgs(msgEthTx2))
+	bz2, err := suite.backend.clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	suite.Require().NoError(err)
+
+	block := tmtypes.MakeBlock(1, []tmtypes.Tx{bz, bz2}, nil, nil)
+	block.ChainID = ChainID
+	resBlock := &tmrpctypes.ResultBlock{Block: block, BlockID: block.LastBlockID}
+	from, to := tests.GenerateAddress(), tests.GenerateAddress()
+	operations := []*evmtracers.InternalOperation{
+		{Type: evmtracers.OperationTransfer, From: from, To: to},
+		{Type: evmtracers.OperationSelfDestruct, From: to, To: from},
+	}
+	results := []*evmtypes.TxTraceResult{{Error: "execution reverted"}, {Result: operations}}
+	registerStream := func(results []*evmtypes.TxTraceResult) {
+		stream := &traceBlockStreamClient{}
+		for i, result := range results {
+			data, _ := json.Marshal(result)
+			stream.responses = append(stream.responses, &evmtypes.QueryTraceBlockStreamResponse{TxIndex: uint64(i), Data: data})
+		}
+		streamClient := mocks.NewEVMQueryClient(suite.T())
+		suite.backend.queryClient.Stream = streamClient
+		streamClient.On("TraceBlockStream", mock.Anything, mock.Anything).Return(stream, nil)
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expAddresses map[common.Hash][]common.Address
+		expPass      bool
+	}{
+		{
+			"pass - the txs failing to be traced have no addresses",
+			func() {
+				registerStream(results)
+			},
+			map[common.Hash][]common.Address{common.HexToHash(msgEthTx2.Hash): {from, to}},
+			true,
+		},
+		{
+			"fail - a trace result is missing",
+			func() {
+				registerStream(results[:1])
+			},
+			nil,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			client := suite.backend.clientCtx.Client.(*mocks.Client)
+			client.On("Block", rpctypes.ContextWithHeight(1), mock.AnythingOfType("*int64")).Return(resBlock, nil)
+			tc.registerMock()
+
+			addresses, err := suite.backend.InternalTxAddresses(1)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expAddresses, addresses)
+				suite.Require().NotContains(addresses, common.HexToHash(msgEthTx.Hash))
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestTraceCall() {
+	toAddr := tests.GenerateAddress()
+	callArgs := evmtypes.TransactionArgs{To: &toAddr}
+	argsBz, err := json.Marshal(callArgs)
+	suite.Require().NoError(err)
+	nonce := hexutil.Uint64(1)
+	overrides := rpctypes.StateOverride{toAddr: {Nonce: &nonce}}
+	overridesBz, err := json.Marshal(overrides)
+	suite.Require().NoError(err)
+	blockNr := rpctypes.BlockNumber(1)
+	blockNrOrHash := rpctypes.BlockNumberOrHash{BlockNumber: &blockNr}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		config       *rpctypes.TraceCallConfig
+		expResult    interface{}
+		expPass      bool
+	}{
+		{
+			"fail - block not found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"fail - query error",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterBlock(client, 1, nil)
+				RegisterTraceCallError(queryClient, &evmtypes.QueryTraceCallRequest{
+					Args: argsBz, BlockNumber: 1, ChainId: suite.backend.chainID.Int64(),
+				})
+			},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"pass - trace with default config",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterBlock(client, 1, nil)
+				RegisterTraceCall(queryClient, &evmtypes.QueryTraceCallRequest{
+					Args: argsBz, BlockNumber: 1, ChainId: suite.backend.chainID.Int64(),
+				})
+			},
+			nil,
+			map[string]interface{}{"failed": false, "test": "hello"},
+			true,
+		},
+		{
+			"pass - trace with tracer and state overrides",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterBlock(client, 1, nil)
+				RegisterTraceCall(queryClient, &evmtypes.QueryTraceCallRequest{
+					Args:        argsBz,
+					TraceConfig: &evmtypes.TraceConfig{Tracer: "callTracer"},
+					Overrides:   overridesBz,
+					BlockNumber: 1,
+					ChainId:     suite.backend.chainID.Int64(),
+				})
+			},
+			&rpctypes.TraceCallConfig{
+				TraceConfig:    evmtypes.TraceConfig{Tracer: "callTracer"},
+				StateOverrides: &overrides,
+			},
+			map[string]interface{}{"failed": false, "test": "hello"},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			res, err := suite.backend.TraceCall(callArgs, blockNrOrHash, tc.config)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResult, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestIntermediateRoots() {
+	msgEthTx, bz := suite.buildEthereumTx()
+	emptyBlock := tmtypes.MakeBlock(1, []tmtypes.Tx{}, nil, nil)
+	emptyBlock.ChainID = ChainID
+	filledBlock := tmtypes.MakeBlock(1, []tmtypes.Tx{bz}, nil, nil)
+	filledBlock.ChainID = ChainID
+	resBlockEmpty := tmrpctypes.ResultBlock{Block: emptyBlock, BlockID: emptyBlock.LastBlockID}
+	resBlockFilled := tmrpctypes.ResultBlock{Block: filledBlock, BlockID: filledBlock.LastBlockID}
+	root := common.BytesToHash([]byte("root"))
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expRoots     []common.Hash
+		resBlock     *tmrpctypes.ResultBlock
+		expPass      bool
+	}{
+		{
+			"pass - no transaction returning empty array",
+			func() {},
+			[]common.Hash{},
+			&resBlockEmpty,
+			true,
+		},
+		{
+			"fail - query error",
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterIntermediateRootsError(queryClient, []*evmtypes.MsgEthereumTx{msgEthTx})
+			},
+			nil,
+			&resBlockFilled,
+			false,
+		},
+		{
+			"pass - returns the root of each transaction",
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterIntermediateRoots(queryClient, []*evmtypes.MsgEthereumTx{msgEthTx}, [][]byte{root.Bytes()})
+			},
+			[]common.Hash{root},
+			&resBlockFilled,
+			true,
+		},
+		{
+			"fail - transaction can't be replayed",
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterIntermediateRootsReplayError(queryClient, []*evmtypes.MsgEthereumTx{msgEthTx}, [][]byte{root.Bytes()}, []string{"invalid nonce"})
+			},
+			nil,
+			&resBlockFilled,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			roots, err := suite.backend.IntermediateRoots(1, tc.resBlock)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expRoots, roots)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
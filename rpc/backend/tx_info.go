@@ -17,6 +17,7 @@ package backend
 
 import (
 	"fmt"
+	"math/big"
 
 	errorsmod "cosmossdk.io/errors"
 	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
@@ -132,11 +133,71 @@ func (b *Backend) getTransactionByHashPending(txHash common.Hash) (*rpctypes.RPC
 	return nil, nil
 }
 
+// GetRawTransaction returns the binary encoding of the ethereum transaction identified
+// by hash, the transaction is looked up in the mempool if it's not included in a block yet.
+func (b *Backend) GetRawTransaction(txHash common.Hash) (hexutil.Bytes, error) {
+	hexTx := txHash.Hex()
+	b.logger.Debug("debug_getRawTransaction", "hash", hexTx)
+
+	res, err := b.GetTxByEthHash(txHash)
+	if err != nil {
+		return b.getRawTransactionPending(txHash)
+	}
+
+	block, err := b.TendermintBlockByNumber(rpctypes.BlockNumber(res.Height))
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := b.clientCtx.TxConfig.TxDecoder()(block.Block.Txs[res.TxIndex])
+	if err != nil {
+		return nil, err
+	}
+
+	// the `res.MsgIndex` is inferred from tx index, should be within the bound.
+	msg, ok := tx.GetMsgs()[res.MsgIndex].(*evmtypes.MsgEthereumTx)
+	if !ok {
+		return nil, errors.New("invalid ethereum tx")
+	}
+
+	return msg.AsTransaction().MarshalBinary()
+}
+
+// getRawTransactionPending returns the binary encoding of the ethereum transaction
+// identified by hash from the mempool.
+func (b *Backend) getRawTransactionPending(txHash common.Hash) (hexutil.Bytes, error) {
+	hexTx := txHash.Hex()
+	txs, err := b.PendingTransactions()
+	if err != nil {
+		b.logger.Debug("tx not found", "hash", hexTx, "error", err.Error())
+		return nil, nil
+	}
+
+	for _, tx := range txs {
+		msg, err := evmtypes.UnwrapEthereumMsg(tx, txHash)
+		if err != nil {
+			// not ethereum tx
+			continue
+		}
+
+		if msg.Hash == hexTx {
+			return msg.AsTransaction().MarshalBinary()
+		}
+	}
+
+	b.logger.Debug("tx not found", "hash", hexTx)
+	return nil, nil
+}
+
 // GetTransactionReceipt returns the transaction receipt identified by hash.
 func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{}, error) {
 	hexTx := hash.Hex()
 	b.logger.Debug("eth_getTransactionReceipt", "hash", hexTx)
 
+	if receipt, ok := b.cache.getReceipt(hash); ok {
+		return receipt, nil
+	}
+
 	res, err := b.GetTxByEthHash(hash)
 	if err != nil {
 		b.logger.Debug("tx not found", "hash", hexTx, "error", err.Error())
@@ -155,28 +216,70 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 	}
 	ethMsg := tx.GetMsgs()[res.MsgIndex].(*evmtypes.MsgEthereumTx)
 
-	txData, err := evmtypes.UnpackTxData(ethMsg.Data)
+	blockRes, err := b.TendermintBlockResultByNumber(&res.Height)
+	if err != nil {
+		b.logger.Debug("failed to retrieve block results", "height", res.Height, "error", err.Error())
+		return nil, nil
+	}
+
+	chainID, err := b.ChainID()
 	if err != nil {
-		b.logger.Error("failed to unpack tx data", "error", err.Error())
 		return nil, err
 	}
 
-	cumulativeGasUsed := uint64(0)
-	blockRes, err := b.TendermintBlockResultByNumber(&res.Height)
+	if res.EthTxIndex == -1 {
+		// Fallback to find tx index by iterating all valid eth transactions
+		msgs := b.EthMsgsFromTendermintBlock(resBlock, blockRes)
+		for i := range msgs {
+			if msgs[i].Hash == hexTx {
+				res.EthTxIndex = int32(i)
+				break
+			}
+		}
+	}
+	// return error if still unable to find the eth tx index
+	if res.EthTxIndex == -1 {
+		return nil, errors.New("can't find index of ethereum tx")
+	}
+
+	baseFee := func() (*big.Int, error) {
+		return b.BaseFee(blockRes)
+	}
+	blockHash := common.BytesToHash(resBlock.Block.Header.Hash())
+	receipt, err := b.formatTxReceipt(ethMsg, res, blockRes, blockHash, chainID.ToInt(), baseFee)
 	if err != nil {
-		b.logger.Debug("failed to retrieve block results", "height", res.Height, "error", err.Error())
+		return nil, err
+	}
+
+	b.cache.addReceipt(hash, receipt)
+	return receipt, nil
+}
+
+// GetBlockReceipts returns the receipts of all the ethereum transactions of the
+// given block, the block and its results are only fetched once.
+func (b *Backend) GetBlockReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]map[string]interface{}, error) {
+	b.logger.Debug("eth_getBlockReceipts", "block number or hash", blockNrOrHash)
+
+	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	resBlock, err := b.TendermintBlockByNumber(blockNum)
+	if err != nil {
+		b.logger.Debug("block not found", "height", blockNum, "error", err.Error())
 		return nil, nil
 	}
-	for _, txResult := range blockRes.TxsResults[0:res.TxIndex] {
-		cumulativeGasUsed += uint64(txResult.GasUsed)
+
+	// return if requested block height is greater than the current one
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, nil
 	}
-	cumulativeGasUsed += res.CumulativeGasUsed
 
-	var status hexutil.Uint
-	if res.Failed {
-		status = hexutil.Uint(ethtypes.ReceiptStatusFailed)
-	} else {
-		status = hexutil.Uint(ethtypes.ReceiptStatusSuccessful)
+	blockRes, err := b.TendermintBlockResultByNumber(&resBlock.Block.Height)
+	if err != nil {
+		b.logger.Debug("failed to retrieve block results", "height", resBlock.Block.Height, "error", err.Error())
+		return nil, nil
 	}
 
 	chainID, err := b.ChainID()
@@ -184,55 +287,206 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 		return nil, err
 	}
 
-	from, err := ethMsg.GetSender(chainID.ToInt())
+	// the base fee is only queried once, when the first dynamic fee tx is found
+	var (
+		baseFee    *big.Int
+		baseFeeErr error
+		baseFeeSet bool
+	)
+	getBaseFee := func() (*big.Int, error) {
+		if !baseFeeSet {
+			baseFee, baseFeeErr = b.BaseFee(blockRes)
+			baseFeeSet = true
+		}
+		return baseFee, baseFeeErr
+	}
+
+	blockHash := common.BytesToHash(resBlock.Block.Header.Hash())
+	ethMsgs, txResults := b.blockTxResults(resBlock, blockRes)
+	receipts := make([]map[string]interface{}, 0, len(ethMsgs))
+	for i, ethMsg := range ethMsgs {
+		receipt, err := b.formatTxReceipt(ethMsg, txResults[i], blockRes, blockHash, chainID.ToInt(), getBaseFee)
+		if err != nil {
+			return nil, err
+		}
+		receipts = append(receipts, receipt)
+	}
+
+	return receipts, nil
+}
+
+// GetRawReceipts returns the consensus encodings of the receipts of all the
+// ethereum transactions of the given block.
+func (b *Backend) GetRawReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]hexutil.Bytes, error) {
+	b.logger.Debug("debug_getRawReceipts", "block number or hash", blockNrOrHash)
+
+	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
 	if err != nil {
 		return nil, err
 	}
 
-	// parse tx logs from events
-	logs, err := TxLogsFromEvents(blockRes.TxsResults[res.TxIndex].Events, int(res.MsgIndex))
+	resBlock, err := b.TendermintBlockByNumber(blockNum)
 	if err != nil {
-		b.logger.Debug("failed to parse logs", "hash", hexTx, "error", err.Error())
+		return nil, err
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, fmt.Errorf("block not found for height %d", blockNum)
 	}
 
-	if res.EthTxIndex == -1 {
-		// Fallback to find tx index by iterating all valid eth transactions
-		msgs := b.EthMsgsFromTendermintBlock(resBlock, blockRes)
-		for i := range msgs {
-			if msgs[i].Hash == hexTx {
-				res.EthTxIndex = int32(i)
-				break
+	blockRes, err := b.TendermintBlockResultByNumber(&resBlock.Block.Height)
+	if err != nil {
+		return nil, fmt.Errorf("block result not found for height %d", resBlock.Block.Height)
+	}
+
+	ethMsgs, txResults := b.blockTxResults(resBlock, blockRes)
+	receipts := make([]hexutil.Bytes, len(ethMsgs))
+	for i, ethMsg := range ethMsgs {
+		bz, err := b.txReceipt(ethMsg, txResults[i], blockRes).MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		receipts[i] = bz
+	}
+	return receipts, nil
+}
+
+// blockTxResults returns the ethereum messages of the block along with their tx results,
+// the tx results are parsed from the block results instead of being fetched from the indexer.
+func (b *Backend) blockTxResults(
+	resBlock *tmrpctypes.ResultBlock,
+	blockRes *tmrpctypes.ResultBlockResults,
+) ([]*evmtypes.MsgEthereumTx, []*ethermint.TxResult) {
+	var (
+		ethMsgs    []*evmtypes.MsgEthereumTx
+		txResults  []*ethermint.TxResult
+		ethTxIndex int32
+	)
+	for i, txBz := range resBlock.Block.Txs {
+		txResult := blockRes.TxsResults[i]
+		// same filter as EthMsgsFromTendermintBlock so that the tx indexes are consistent
+		if !rpctypes.TxSuccessOrExceedsBlockGasLimit(txResult) {
+			continue
+		}
+
+		tx, err := b.clientCtx.TxConfig.TxDecoder()(txBz)
+		if err != nil {
+			b.logger.Debug("failed to decode transaction in block", "height", resBlock.Block.Height, "error", err.Error())
+			continue
+		}
+
+		parsedTxs, err := rpctypes.ParseTxResult(txResult, tx)
+		if err != nil {
+			b.logger.Debug("failed to parse tx events", "height", resBlock.Block.Height, "index", i, "error", err.Error())
+			continue
+		}
+
+		for _, parsedTx := range parsedTxs.Txs {
+			ethMsg, ok := tx.GetMsgs()[parsedTx.MsgIndex].(*evmtypes.MsgEthereumTx)
+			if !ok {
+				continue
+			}
+
+			res := &ethermint.TxResult{
+				Height:            resBlock.Block.Height,
+				TxIndex:           uint32(i),
+				MsgIndex:          uint32(parsedTx.MsgIndex),
+				EthTxIndex:        parsedTx.EthTxIndex,
+				Failed:            parsedTx.Failed,
+				GasUsed:           parsedTx.GasUsed,
+				CumulativeGasUsed: parsedTxs.AccumulativeGasUsed(parsedTx.MsgIndex),
+			}
+			if res.EthTxIndex == -1 {
+				res.EthTxIndex = ethTxIndex
 			}
+			ethTxIndex++
+
+			ethMsgs = append(ethMsgs, ethMsg)
+			txResults = append(txResults, res)
 		}
 	}
-	// return error if still unable to find the eth tx index
-	if res.EthTxIndex == -1 {
-		return nil, errors.New("can't find index of ethereum tx")
+	return ethMsgs, txResults
+}
+
+// txReceipt returns the consensus fields of the receipt of the ethereum message with
+// the given tx result, the logs and the cumulative gas used are taken from the block results.
+func (b *Backend) txReceipt(
+	ethMsg *evmtypes.MsgEthereumTx,
+	res *ethermint.TxResult,
+	blockRes *tmrpctypes.ResultBlockResults,
+) *ethtypes.Receipt {
+	cumulativeGasUsed := uint64(0)
+	for _, txResult := range blockRes.TxsResults[0:res.TxIndex] {
+		cumulativeGasUsed += uint64(txResult.GasUsed)
 	}
+	cumulativeGasUsed += res.CumulativeGasUsed
+
+	status := ethtypes.ReceiptStatusSuccessful
+	if res.Failed {
+		status = ethtypes.ReceiptStatusFailed
+	}
+
+	// parse tx logs from events
+	logs, err := TxLogsFromEvents(blockRes.TxsResults[res.TxIndex].Events, int(res.MsgIndex))
+	if err != nil {
+		b.logger.Debug("failed to parse logs", "hash", ethMsg.Hash, "error", err.Error())
+	}
+
+	return &ethtypes.Receipt{
+		Type:              ethMsg.AsTransaction().Type(),
+		Status:            status,
+		CumulativeGasUsed: cumulativeGasUsed,
+		Bloom:             ethtypes.BytesToBloom(ethtypes.LogsBloom(logs)),
+		Logs:              logs,
+	}
+}
+
+// formatTxReceipt returns the receipt of the ethereum message with the given tx
+// result, the logs and the cumulative gas used are taken from the block results.
+func (b *Backend) formatTxReceipt(
+	ethMsg *evmtypes.MsgEthereumTx,
+	res *ethermint.TxResult,
+	blockRes *tmrpctypes.ResultBlockResults,
+	blockHash common.Hash,
+	chainID *big.Int,
+	baseFee func() (*big.Int, error),
+) (map[string]interface{}, error) {
+	txData, err := evmtypes.UnpackTxData(ethMsg.Data)
+	if err != nil {
+		b.logger.Error("failed to unpack tx data", "error", err.Error())
+		return nil, err
+	}
+
+	from, err := ethMsg.GetSender(chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	ethReceipt := b.txReceipt(ethMsg, res, blockRes)
+	logs := ethReceipt.Logs
 
 	receipt := map[string]interface{}{
 		// Consensus fields: These fields are defined by the Yellow Paper
-		"status":            status,
-		"cumulativeGasUsed": hexutil.Uint64(cumulativeGasUsed),
-		"logsBloom":         ethtypes.BytesToBloom(ethtypes.LogsBloom(logs)),
+		"status":            hexutil.Uint(ethReceipt.Status),
+		"cumulativeGasUsed": hexutil.Uint64(ethReceipt.CumulativeGasUsed),
+		"logsBloom":         ethReceipt.Bloom,
 		"logs":              logs,
 
 		// Implementation fields: These fields are added by geth when processing a transaction.
 		// They are stored in the chain database.
-		"transactionHash": hash,
+		"transactionHash": ethMsg.AsTransaction().Hash(),
 		"contractAddress": nil,
 		"gasUsed":         hexutil.Uint64(res.GasUsed),
 
 		// Inclusion information: These fields provide information about the inclusion of the
 		// transaction corresponding to this receipt.
-		"blockHash":        common.BytesToHash(resBlock.Block.Header.Hash()).Hex(),
+		"blockHash":        blockHash.Hex(),
 		"blockNumber":      hexutil.Uint64(res.Height),
 		"transactionIndex": hexutil.Uint64(res.EthTxIndex),
 
 		// sender and receiver (contract or EOA) addreses
 		"from": from,
 		"to":   txData.GetTo(),
-		"type": hexutil.Uint(ethMsg.AsTransaction().Type()),
+		"type": hexutil.Uint(ethReceipt.Type),
 	}
 
 	if logs == nil {
@@ -245,7 +499,7 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 	}
 
 	if dynamicTx, ok := txData.(*evmtypes.DynamicFeeTx); ok {
-		baseFee, err := b.BaseFee(blockRes)
+		baseFee, err := baseFee()
 		if err != nil {
 			// tolerate the error for pruned node.
 			b.logger.Error("fetch basefee failed, node is pruned?", "height", res.Height, "error", err)
@@ -335,6 +589,22 @@ func (b *Backend) GetTxByTxIndex(height int64, index uint) (*ethermint.TxResult,
 	return txResult, nil
 }
 
+// GetTxsByAddress returns the hashes of the txs sent by, sent to or creating the address
+// in the blocks before the height if reverse, otherwise after the height. It requires
+// the custom tx indexer which keeps the tx lists of the addresses.
+func (b *Backend) GetTxsByAddress(address common.Address, height int64, reverse bool, pageSize int) ([]common.Hash, bool, error) {
+	addressIndexer, ok := b.indexer.(ethermint.EVMAddressIndexer)
+	if !ok {
+		return nil, false, errors.New("the address txs search requires the custom tx indexer to be enabled")
+	}
+
+	hashes, more, err := addressIndexer.GetTxsByAddress(address, height, reverse, pageSize)
+	if err != nil {
+		return nil, false, errorsmod.Wrapf(err, "GetTxsByAddress %s", address.Hex())
+	}
+	return hashes, more, nil
+}
+
 // queryTendermintTxIndexer query tx in tendermint tx indexer
 func (b *Backend) queryTendermintTxIndexer(query string, txGetter func(*rpctypes.ParsedTxs) *rpctypes.ParsedTx) (*ethermint.TxResult, error) {
 	resTxs, err := b.clientCtx.Client.TxSearch(b.ctx, query, false, nil, nil, "")
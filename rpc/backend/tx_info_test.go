@@ -12,11 +12,13 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/evmos/ethermint/indexer"
 	"github.com/evmos/ethermint/rpc/backend/mocks"
 	rpctypes "github.com/evmos/ethermint/rpc/types"
 	ethermint "github.com/evmos/ethermint/types"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc/metadata"
 )
 
@@ -137,7 +139,7 @@ func (suite *BackendTestSuite) TestGetTransactionsByHashPending() {
 			"fail - Pending transactions returns error",
 			func() {
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
-				RegisterUnconfirmedTxsError(client, nil)
+				RegisterUnconfirmedTxsError(client)
 			},
 			msgEthereumTx,
 			nil,
@@ -147,7 +149,7 @@ func (suite *BackendTestSuite) TestGetTransactionsByHashPending() {
 			"fail - Tx not found return nil",
 			func() {
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
-				RegisterUnconfirmedTxs(client, nil, nil)
+				RegisterUnconfirmedTxs(client, nil)
 			},
 			msgEthereumTx,
 			nil,
@@ -157,7 +159,7 @@ func (suite *BackendTestSuite) TestGetTransactionsByHashPending() {
 			"pass - Tx found and returned",
 			func() {
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
-				RegisterUnconfirmedTxs(client, nil, types.Txs{bz})
+				RegisterUnconfirmedTxs(client, types.Txs{bz})
 			},
 			msgEthereumTx,
 			rpcTransaction,
@@ -224,6 +226,86 @@ func (suite *BackendTestSuite) TestGetTxByEthHash() {
 	}
 }
 
+func (suite *BackendTestSuite) TestGetTxsByAddress() {
+	msgEthereumTx, _ := suite.buildEthereumTx()
+	txBz := suite.signAndEncodeEthTx(msgEthereumTx)
+	txHash := common.HexToHash(msgEthereumTx.Hash)
+	from, err := msgEthereumTx.GetSender(suite.backend.chainID)
+	suite.Require().NoError(err)
+
+	block := &types.Block{Header: types.Header{Height: 1, ChainID: "test"}, Data: types.Data{Txs: []types.Tx{txBz}}}
+	responseDeliver := []*abci.ResponseDeliverTx{
+		{
+			Code: 0,
+			Events: []abci.Event{
+				{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "txGasUsed", Value: "21000"},
+				}},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		address   common.Address
+		expHashes []common.Hash
+		expPass   bool
+	}{
+		{
+			"fail - indexer disabled",
+			func() {
+				suite.backend.indexer = nil
+			},
+			from,
+			nil,
+			false,
+		},
+		{
+			"pass - sender",
+			func() {},
+			from,
+			[]common.Hash{txHash},
+			true,
+		},
+		{
+			"pass - recipient",
+			func() {},
+			common.Address{},
+			[]common.Hash{txHash},
+			true,
+		},
+		{
+			"pass - unknown address",
+			func() {},
+			common.BigToAddress(common.Big1),
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			suite.backend.indexer = indexer.NewKVIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), suite.backend.clientCtx)
+			err := suite.backend.indexer.IndexBlock(block, responseDeliver)
+			suite.Require().NoError(err)
+			tc.malleate()
+
+			hashes, more, err := suite.backend.GetTxsByAddress(tc.address, 2, true, 10)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expHashes, hashes)
+				suite.Require().False(more)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestGetTransactionByBlockHashAndIndex() {
 	_, bz := suite.buildEthereumTx()
 
@@ -598,3 +680,320 @@ func (suite *BackendTestSuite) TestGetTransactionReceipt() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestGetBlockReceipts() {
+	msgEthereumTx, _ := suite.buildEthereumTx()
+	txBz := suite.signAndEncodeEthTx(msgEthereumTx)
+	txHash := msgEthereumTx.AsTransaction().Hash()
+	blockNum := rpctypes.BlockNumber(1)
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expReceipts  []map[string]interface{}
+		expPass      bool
+	}{
+		{
+			"pass - block not found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			nil,
+			true,
+		},
+		{
+			"pass - block results not found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlock(client, 1, txBz)
+				RegisterBlockResultsError(client, 1)
+			},
+			nil,
+			true,
+		},
+		{
+			"pass - block without transactions",
+			func() {
+				var header metadata.MD
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterParams(queryClient, &header, 1)
+				RegisterParamsWithoutHeader(queryClient, 1)
+				RegisterBlock(client, 1, nil)
+				RegisterBlockResults(client, 1)
+			},
+			[]map[string]interface{}{},
+			true,
+		},
+		{
+			"pass - block with a transaction",
+			func() {
+				var header metadata.MD
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterParams(queryClient, &header, 1)
+				RegisterParamsWithoutHeader(queryClient, 1)
+				RegisterBlock(client, 1, txBz)
+				client.On("BlockResults", rpctypes.ContextWithHeight(1), mock.AnythingOfType("*int64")).
+					Return(&tmrpctypes.ResultBlockResults{
+						Height: 1,
+						TxsResults: []*abci.ResponseDeliverTx{
+							{
+								Code:    0,
+								GasUsed: 21000,
+								Events: []abci.Event{
+									{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+										{Key: "ethereumTxHash", Value: txHash.Hex()},
+										{Key: "txIndex", Value: "0"},
+										{Key: "amount", Value: "1000"},
+										{Key: "txGasUsed", Value: "21000"},
+										{Key: "txHash", Value: ""},
+										{Key: "recipient", Value: common.Address{}.Hex()},
+									}},
+								},
+							},
+						},
+					}, nil)
+			},
+			[]map[string]interface{}{
+				{
+					"transactionHash":   txHash,
+					"transactionIndex":  hexutil.Uint64(0),
+					"blockNumber":       hexutil.Uint64(1),
+					"status":            hexutil.Uint(ethtypes.ReceiptStatusSuccessful),
+					"gasUsed":           hexutil.Uint64(21000),
+					"cumulativeGasUsed": hexutil.Uint64(21000),
+				},
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			tc.registerMock()
+
+			receipts, err := suite.backend.GetBlockReceipts(rpctypes.BlockNumberOrHash{BlockNumber: &blockNum})
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Len(receipts, len(tc.expReceipts))
+			if tc.expReceipts == nil {
+				suite.Require().Nil(receipts)
+			}
+			for i, expReceipt := range tc.expReceipts {
+				for key, value := range expReceipt {
+					suite.Require().Equal(value, receipts[i][key], key)
+				}
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestGetRawTransaction() {
+	msgEthereumTx, _ := suite.buildEthereumTx()
+	txBz := suite.signAndEncodeEthTx(msgEthereumTx)
+	txHash := msgEthereumTx.AsTransaction().Hash()
+	rawTx, err := msgEthereumTx.AsTransaction().MarshalBinary()
+	suite.Require().NoError(err)
+
+	pendingMsg, pendingBz := suite.buildEthereumTx()
+	rawPendingTx, err := pendingMsg.AsTransaction().MarshalBinary()
+	suite.Require().NoError(err)
+
+	block := &types.Block{Header: types.Header{Height: 1, ChainID: "test"}, Data: types.Data{Txs: []types.Tx{txBz}}}
+	responseDeliver := []*abci.ResponseDeliverTx{
+		{
+			Code: 0,
+			Events: []abci.Event{
+				{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "amount", Value: "1000"},
+					{Key: "txGasUsed", Value: "21000"},
+					{Key: "txHash", Value: ""},
+					{Key: "recipient", Value: ""},
+				}},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		hash         common.Hash
+		expRaw       hexutil.Bytes
+		expPass      bool
+	}{
+		{
+			"fail - block error",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			txHash,
+			nil,
+			false,
+		},
+		{
+			"pass - mined transaction",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlock(client, 1, txBz)
+			},
+			txHash,
+			rawTx,
+			true,
+		},
+		{
+			"pass - pending transaction",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxs(client, types.Txs{pendingBz})
+			},
+			common.HexToHash(pendingMsg.Hash),
+			rawPendingTx,
+			true,
+		},
+		{
+			"pass - transaction not found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxs(client, nil)
+			},
+			common.BytesToHash([]byte("unknown")),
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			tc.registerMock()
+
+			db := dbm.NewMemDB()
+			suite.backend.indexer = indexer.NewKVIndexer(db, tmlog.NewNopLogger(), suite.backend.clientCtx)
+			err := suite.backend.indexer.IndexBlock(block, responseDeliver)
+			suite.Require().NoError(err)
+
+			raw, err := suite.backend.GetRawTransaction(tc.hash)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expRaw, raw)
+			if raw != nil {
+				// the encoding decodes back to the requested transaction
+				tx := new(ethtypes.Transaction)
+				suite.Require().NoError(tx.UnmarshalBinary(raw))
+				suite.Require().Equal(tc.hash, tx.Hash())
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestGetRawReceipts() {
+	msgEthereumTx, _ := suite.buildEthereumTx()
+	txBz := suite.signAndEncodeEthTx(msgEthereumTx)
+	txHash := msgEthereumTx.AsTransaction().Hash()
+	blockNum := rpctypes.BlockNumber(1)
+
+	rawReceipt, err := (&ethtypes.Receipt{
+		Type:              msgEthereumTx.AsTransaction().Type(),
+		Status:            ethtypes.ReceiptStatusSuccessful,
+		CumulativeGasUsed: 21000,
+	}).MarshalBinary()
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expReceipts  []hexutil.Bytes
+		expPass      bool
+	}{
+		{
+			"fail - block not found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - block results not found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlock(client, 1, txBz)
+				RegisterBlockResultsError(client, 1)
+			},
+			nil,
+			false,
+		},
+		{
+			"pass - block without transactions",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlock(client, 1, nil)
+				RegisterBlockResults(client, 1)
+			},
+			[]hexutil.Bytes{},
+			true,
+		},
+		{
+			"pass - block with a transaction",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlock(client, 1, txBz)
+				client.On("BlockResults", rpctypes.ContextWithHeight(1), mock.AnythingOfType("*int64")).
+					Return(&tmrpctypes.ResultBlockResults{
+						Height: 1,
+						TxsResults: []*abci.ResponseDeliverTx{
+							{
+								Code:    0,
+								GasUsed: 21000,
+								Events: []abci.Event{
+									{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+										{Key: "ethereumTxHash", Value: txHash.Hex()},
+										{Key: "txIndex", Value: "0"},
+										{Key: "amount", Value: "1000"},
+										{Key: "txGasUsed", Value: "21000"},
+										{Key: "txHash", Value: ""},
+										{Key: "recipient", Value: common.Address{}.Hex()},
+									}},
+								},
+							},
+						},
+					}, nil)
+			},
+			[]hexutil.Bytes{rawReceipt},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			tc.registerMock()
+
+			receipts, err := suite.backend.GetRawReceipts(rpctypes.BlockNumberOrHash{BlockNumber: &blockNum})
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expReceipts, receipts)
+			for _, raw := range receipts {
+				// the encoding decodes back to a consensus receipt
+				receipt := new(ethtypes.Receipt)
+				suite.Require().NoError(receipt.UnmarshalBinary(raw))
+			}
+		})
+	}
+}
@@ -0,0 +1,180 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package backend
+
+import (
+	"sort"
+
+	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	"github.com/pkg/errors"
+)
+
+// maxUnconfirmedTxs is the max number of txs returned by the CometBFT `unconfirmed_txs`
+// query, which can't be paginated
+const maxUnconfirmedTxs = 100
+
+// TxPoolContent returns the ethereum transactions of the mempool grouped by sender.
+// The pending transactions have contiguous nonces starting from the account nonce,
+// the queued ones are the transactions following a nonce gap.
+func (b *Backend) TxPoolContent() (
+	pending map[common.Address][]*evmtypes.MsgEthereumTx,
+	queued map[common.Address][]*evmtypes.MsgEthereumTx,
+	err error,
+) {
+	pendingTxs, err := b.PendingTransactions()
+	if err != nil {
+		return nil, nil, err
+	}
+	return b.txPoolContent(pendingTxs)
+}
+
+// TxPoolStatus returns the number of pending and queued ethereum transactions of the
+// mempool. The transactions beyond the ones returned by `PendingTransactions` are
+// counted as pending, so that the total matches the size of the mempool.
+func (b *Backend) TxPoolStatus() (pending, queued int, err error) {
+	mc, ok := b.clientCtx.Client.(tmrpcclient.MempoolClient)
+	if !ok {
+		return 0, 0, errors.New("invalid rpc client")
+	}
+	res, err := mc.NumUnconfirmedTxs(b.ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	pendingTxs, err := b.PendingTransactions()
+	if err != nil {
+		return 0, 0, err
+	}
+	pendingMsgs, queuedMsgs, err := b.txPoolContent(pendingTxs)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	for _, msgs := range pendingMsgs {
+		pending += len(msgs)
+	}
+	for _, msgs := range queuedMsgs {
+		queued += len(msgs)
+	}
+	if res.Total > len(pendingTxs) {
+		pending += res.Total - len(pendingTxs)
+	}
+	return pending, queued, nil
+}
+
+// txPoolContent groups the ethereum transactions of the mempool by sender and splits
+// them in pending and queued ones.
+func (b *Backend) txPoolContent(pendingTxs []*sdk.Tx) (
+	pending map[common.Address][]*evmtypes.MsgEthereumTx,
+	queued map[common.Address][]*evmtypes.MsgEthereumTx,
+	err error,
+) {
+	pending = make(map[common.Address][]*evmtypes.MsgEthereumTx)
+	queued = make(map[common.Address][]*evmtypes.MsgEthereumTx)
+	for sender, msgs := range b.ethMsgsBySender(pendingTxs) {
+		accPending, accQueued, err := b.splitPendingQueued(sender, msgs)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(accPending) > 0 {
+			pending[sender] = accPending
+		}
+		if len(accQueued) > 0 {
+			queued[sender] = accQueued
+		}
+	}
+	return pending, queued, nil
+}
+
+// TxPoolContentFrom returns the pending and queued ethereum transactions of the
+// mempool sent by the given address.
+func (b *Backend) TxPoolContentFrom(address common.Address) (
+	pending []*evmtypes.MsgEthereumTx,
+	queued []*evmtypes.MsgEthereumTx,
+	err error,
+) {
+	pendingTxs, err := b.PendingTransactions()
+	if err != nil {
+		return nil, nil, err
+	}
+	return b.splitPendingQueued(address, b.ethMsgsBySender(pendingTxs)[address])
+}
+
+// ethMsgsBySender returns the ethereum messages of the mempool transactions grouped by sender.
+func (b *Backend) ethMsgsBySender(pendingTxs []*sdk.Tx) map[common.Address][]*evmtypes.MsgEthereumTx {
+	result := make(map[common.Address][]*evmtypes.MsgEthereumTx)
+	for _, tx := range pendingTxs {
+		for _, msg := range (*tx).GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				// not ethereum tx
+				break
+			}
+
+			sender, err := ethMsg.GetSender(b.chainID)
+			if err != nil {
+				b.logger.Debug("failed to get sender of pending tx", "hash", ethMsg.Hash, "error", err.Error())
+				continue
+			}
+			result[sender] = append(result[sender], ethMsg)
+		}
+	}
+	return result
+}
+
+// splitPendingQueued sorts the messages of the sender by nonce and splits them in
+// the pending ones, contiguous from the account nonce, and the queued ones. The
+// messages with a nonce lower than the account nonce are already executed and
+// the duplicated nonces are ignored.
+func (b *Backend) splitPendingQueued(sender common.Address, msgs []*evmtypes.MsgEthereumTx) (
+	pending []*evmtypes.MsgEthereumTx,
+	queued []*evmtypes.MsgEthereumTx,
+	err error,
+) {
+	if len(msgs) == 0 {
+		return nil, nil, nil
+	}
+
+	nonce, err := b.getAccountNonce(sender, false, 0, b.logger)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sort.SliceStable(msgs, func(i, j int) bool {
+		return msgs[i].AsTransaction().Nonce() < msgs[j].AsTransaction().Nonce()
+	})
+
+	gapped := false
+	for i, msg := range msgs {
+		txNonce := msg.AsTransaction().Nonce()
+		if txNonce < nonce || (i > 0 && txNonce == msgs[i-1].AsTransaction().Nonce()) {
+			continue
+		}
+		if txNonce != nonce {
+			gapped = true
+		}
+		if gapped {
+			queued = append(queued, msg)
+			continue
+		}
+		pending = append(pending, msg)
+		nonce++
+	}
+	return pending, queued, nil
+}
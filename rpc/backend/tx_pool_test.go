@@ -0,0 +1,225 @@
+package backend
+
+import (
+	"fmt"
+	"math/big"
+
+	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
+	"github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/ethermint/rpc/backend/mocks"
+	"github.com/evmos/ethermint/tests"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// buildMempoolTx returns a signed ethereum tx of the given sender and its encoding.
+func (suite *BackendTestSuite) buildMempoolTx(from common.Address, signer keyring.Signer, nonce uint64) (*evmtypes.MsgEthereumTx, types.Tx) {
+	msg := evmtypes.NewTx(suite.backend.chainID, nonce, &common.Address{}, big.NewInt(0), 100000, big.NewInt(1), nil, nil, nil, nil)
+	msg.From = from.String()
+	err := msg.Sign(ethtypes.LatestSigner(suite.backend.ChainConfig()), signer)
+	suite.Require().NoError(err)
+
+	tx, err := msg.BuildTx(suite.backend.clientCtx.TxConfig.NewTxBuilder(), "aphoton")
+	suite.Require().NoError(err)
+	txBz, err := suite.backend.clientCtx.TxConfig.TxEncoder()(tx)
+	suite.Require().NoError(err)
+	return msg, txBz
+}
+
+// registerMempoolAccount registers the account of the sender with the given sequence.
+func (suite *BackendTestSuite) registerMempoolAccount(from common.Address, sequence uint64) {
+	client := suite.backend.clientCtx.Client.(*mocks.Client)
+	accAddr := sdk.AccAddress(from.Bytes())
+	request := &authtypes.QueryAccountRequest{Address: accAddr.String()}
+	requestMarshal, _ := request.Marshal()
+	RegisterABCIQueryAccount(
+		client,
+		requestMarshal,
+		tmrpcclient.ABCIQueryOptions{Height: int64(1), Prove: false},
+		authtypes.NewBaseAccount(accAddr, nil, 1, sequence),
+	)
+}
+
+func (suite *BackendTestSuite) TestTxPoolContent() {
+	from, priv := tests.NewAddrKey()
+	signer := tests.NewSigner(priv)
+
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+	RegisterParamsWithoutHeader(queryClient, 1)
+
+	buildTx := func(nonce uint64) (*evmtypes.MsgEthereumTx, types.Tx) {
+		return suite.buildMempoolTx(from, signer, nonce)
+	}
+	registerAccount := func(sequence uint64) {
+		suite.registerMempoolAccount(from, sequence)
+	}
+
+	msg0, tx0 := buildTx(0)
+	msg1, tx1 := buildTx(1)
+	msg3, tx3 := buildTx(3)
+
+	// more txs than the default page of the mempool query
+	var manyMsgs []*evmtypes.MsgEthereumTx
+	var manyTxs []types.Tx
+	for nonce := uint64(0); nonce < 40; nonce++ {
+		msg, tx := buildTx(nonce)
+		manyMsgs = append(manyMsgs, msg)
+		manyTxs = append(manyTxs, tx)
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expPending   []*evmtypes.MsgEthereumTx
+		expQueued    []*evmtypes.MsgEthereumTx
+		expPass      bool
+	}{
+		{
+			"fail - unconfirmed txs error",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxsError(client)
+			},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"pass - empty mempool",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxs(client, nil)
+			},
+			nil,
+			nil,
+			true,
+		},
+		{
+			"pass - contiguous nonces are pending",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxs(client, []types.Tx{tx1, tx0})
+				registerAccount(0)
+			},
+			[]*evmtypes.MsgEthereumTx{msg0, msg1},
+			nil,
+			true,
+		},
+		{
+			"pass - nonces after a gap are queued",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxs(client, []types.Tx{tx3, tx0, tx1})
+				registerAccount(0)
+			},
+			[]*evmtypes.MsgEthereumTx{msg0, msg1},
+			[]*evmtypes.MsgEthereumTx{msg3},
+			true,
+		},
+		{
+			"pass - all nonces are queued if the account nonce is missing",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxs(client, []types.Tx{tx1, tx3})
+				registerAccount(0)
+			},
+			nil,
+			[]*evmtypes.MsgEthereumTx{msg1, msg3},
+			true,
+		},
+		{
+			"pass - nonces lower than the account nonce are skipped",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxs(client, []types.Tx{tx0, tx1, tx3})
+				registerAccount(1)
+			},
+			[]*evmtypes.MsgEthereumTx{msg1},
+			[]*evmtypes.MsgEthereumTx{msg3},
+			true,
+		},
+		{
+			"pass - more txs than the default page",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxs(client, manyTxs)
+				registerAccount(0)
+			},
+			manyMsgs,
+			nil,
+			true,
+		},
+	}
+
+	hashes := func(msgs []*evmtypes.MsgEthereumTx) []common.Hash {
+		var res []common.Hash
+		for _, msg := range msgs {
+			res = append(res, msg.AsTransaction().Hash())
+		}
+		return res
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			tc.registerMock()
+
+			pending, queued, err := suite.backend.TxPoolContent()
+			pendingFrom, queuedFrom, errFrom := suite.backend.TxPoolContentFrom(from)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NoError(errFrom)
+				suite.Require().Equal(hashes(tc.expPending), hashes(pending[from]))
+				suite.Require().Equal(hashes(tc.expQueued), hashes(queued[from]))
+				suite.Require().Equal(hashes(tc.expPending), hashes(pendingFrom))
+				suite.Require().Equal(hashes(tc.expQueued), hashes(queuedFrom))
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Error(errFrom)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestTxPoolStatus() {
+	from, priv := tests.NewAddrKey()
+	signer := tests.NewSigner(priv)
+
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+	RegisterParamsWithoutHeader(queryClient, 1)
+
+	var txs []types.Tx
+	for _, nonce := range []uint64{0, 1, 3} {
+		_, tx := suite.buildMempoolTx(from, signer, nonce)
+		txs = append(txs, tx)
+	}
+
+	testCases := []struct {
+		name       string
+		total      int
+		expPending int
+		expQueued  int
+	}{
+		{"pass - whole mempool returned", len(txs), 2, 1},
+		{"pass - txs beyond the returned ones are pending", len(txs) + 50, 52, 1},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			client := suite.backend.clientCtx.Client.(*mocks.Client)
+			RegisterNumUnconfirmedTxs(client, tc.total)
+			RegisterUnconfirmedTxs(client, txs)
+			suite.registerMempoolAccount(from, 0)
+
+			pending, queued, err := suite.backend.TxPoolStatus()
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expPending, pending)
+			suite.Require().Equal(tc.expQueued, queued)
+		})
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package jsonrpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+)
+
+// MaxRequestContentLength is the max size of a request body, same as the go-ethereum http server
+const MaxRequestContentLength = 1024 * 1024 * 5
+
+// Request is the part of a JSON-RPC request read by the handlers.
+type Request struct {
+	ID     json.RawMessage `json:"id,omitempty"`
+	Method string          `json:"method"`
+	// Raw is the encoded request, the encoded element for the elements of a batch
+	Raw json.RawMessage `json:"-"`
+}
+
+// Body is a parsed single or batch JSON-RPC request body.
+type Body struct {
+	Requests []Request
+	Batch    bool
+}
+
+// ParseBody decodes the single or batch JSON-RPC request, a malformed request is returned
+// as a single request without method so that it's still accounted for.
+func ParseBody(raw []byte) *Body {
+	if IsBatch(raw) {
+		var elems []json.RawMessage
+		if err := json.Unmarshal(raw, &elems); err == nil && len(elems) > 0 {
+			reqs := make([]Request, len(elems))
+			for i, elem := range elems {
+				_ = json.Unmarshal(elem, &reqs[i])
+				reqs[i].Raw = elem
+			}
+			return &Body{Requests: reqs, Batch: true}
+		}
+	}
+
+	var req Request
+	_ = json.Unmarshal(raw, &req)
+	req.Raw = raw
+	return &Body{Requests: []Request{req}}
+}
+
+// Methods returns the methods of the requests.
+func (b *Body) Methods() []string {
+	methods := make([]string, len(b.Requests))
+	for i, req := range b.Requests {
+		methods[i] = req.Method
+	}
+	return methods
+}
+
+// ErrorResponses returns the error response of the requests, one per element if it's a
+// batch request.
+func (b *Body) ErrorResponses(code int, message string) interface{} {
+	responses := make([]*ErrorResponse, len(b.Requests))
+	for i, req := range b.Requests {
+		responses[i] = NewErrorResponse(req.ID, code, message)
+	}
+	if b.Batch {
+		return responses
+	}
+	return responses[0]
+}
+
+type bodyKey struct{}
+
+// ReadBody returns the parsed body of the http request. The body is read and parsed by the
+// first handler calling it and passed to the next ones in the context of the returned
+// request, whose body can be read again.
+func ReadBody(w http.ResponseWriter, r *http.Request) (*http.Request, *Body, error) {
+	if body, ok := r.Context().Value(bodyKey{}).(*Body); ok {
+		return r, body, nil
+	}
+	raw, err := io.ReadAll(http.MaxBytesReader(w, r.Body, MaxRequestContentLength))
+	if err != nil {
+		return r, nil, err
+	}
+	r, body := WithBody(r, raw)
+	return r, body, nil
+}
+
+// WithBody returns a copy of the http request with the raw body, which carries the parsed
+// body for the next handlers.
+func WithBody(r *http.Request, raw []byte) (*http.Request, *Body) {
+	body := ParseBody(raw)
+	r = r.WithContext(context.WithValue(r.Context(), bodyKey{}, body))
+	r.Body = io.NopCloser(bytes.NewReader(raw))
+	r.ContentLength = int64(len(raw))
+	return r, body
+}
+
+// ErrorResponse is a JSON-RPC error response.
+type ErrorResponse struct {
+	Jsonrpc string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Error   *ErrorMessage   `json:"error"`
+}
+
+// ErrorMessage is the error of a JSON-RPC error response.
+type ErrorMessage struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// NewErrorResponse returns the error response of the request with the id, the id is null
+// if the request has none.
+func NewErrorResponse(id json.RawMessage, code int, message string) *ErrorResponse {
+	if len(id) == 0 {
+		id = json.RawMessage("null")
+	}
+	return &ErrorResponse{
+		Jsonrpc: "2.0",
+		ID:      id,
+		Error: &ErrorMessage{
+			Code:    code,
+			Message: message,
+		},
+	}
+}
+
+// WriteJSON writes the JSON encoded value as the response.
+func WriteJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// IsBatch returns true when the first non-whitespace character is '[', same as the
+// go-ethereum rpc server.
+func IsBatch(raw []byte) bool {
+	for _, c := range raw {
+		// skip insignificant whitespace (http://www.ietf.org/rfc/rfc4627.txt)
+		if c == 0x20 || c == 0x09 || c == 0x0a || c == 0x0d {
+			continue
+		}
+		return c == '['
+	}
+	return false
+}
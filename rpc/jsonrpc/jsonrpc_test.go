@@ -0,0 +1,86 @@
+package jsonrpc_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/rpc/jsonrpc"
+)
+
+func TestParseBody(t *testing.T) {
+	testCases := []struct {
+		name       string
+		body       string
+		expMethods []string
+		expIDs     []string
+		expBatch   bool
+	}{
+		{"single", `{"jsonrpc":"2.0","id":1,"method":"eth_getLogs","params":[]}`, []string{"eth_getLogs"}, []string{"1"}, false},
+		{"batch", ` [{"id":1,"method":"eth_call"},{"id":"a","method":"eth_getLogs"}]`, []string{"eth_call", "eth_getLogs"}, []string{"1", `"a"`}, true},
+		{"malformed element", `[{"id":1,"method":"eth_call"},2]`, []string{"eth_call", ""}, []string{"1", ""}, true},
+		{"empty batch", `[]`, []string{""}, []string{""}, false},
+		{"malformed", `{"method":`, []string{""}, []string{""}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			body := jsonrpc.ParseBody([]byte(tc.body))
+			require.Equal(t, tc.expMethods, body.Methods())
+			require.Equal(t, tc.expBatch, body.Batch)
+			for i, req := range body.Requests {
+				require.Equal(t, tc.expIDs[i], string(req.ID))
+			}
+		})
+	}
+}
+
+func TestReadBody(t *testing.T) {
+	var reads int
+	inner := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r, body, err := jsonrpc.ReadBody(w, r)
+		require.NoError(t, err)
+		require.Equal(t, []string{"eth_chainId"}, body.Methods())
+		// the body can still be read by the rpc server
+		raw, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"id":1,"method":"eth_chainId"}`, string(raw))
+	})
+	outer := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reads++
+		r, _, err := jsonrpc.ReadBody(w, r)
+		require.NoError(t, err)
+		// the body of the request is consumed, the next handler reads the parsed one
+		r.Body = io.NopCloser(strings.NewReader(""))
+		_, body, err := jsonrpc.ReadBody(w, r)
+		require.NoError(t, err)
+		require.Equal(t, []string{"eth_chainId"}, body.Methods())
+
+		r, body = jsonrpc.WithBody(r, []byte(`{"id":1,"method":"eth_chainId"}`))
+		require.False(t, body.Batch)
+		inner.ServeHTTP(w, r)
+	})
+
+	rec := httptest.NewRecorder()
+	outer.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"id":7,"method":"eth_chainId"}`)))
+	require.Equal(t, 1, reads)
+	require.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestErrorResponses(t *testing.T) {
+	res := jsonrpc.ParseBody([]byte(`{"method":"eth_chainId"}`)).ErrorResponses(-32005, "limit exceeded")
+	single, ok := res.(*jsonrpc.ErrorResponse)
+	require.True(t, ok)
+	require.Equal(t, "null", string(single.ID))
+	require.Equal(t, -32005, single.Error.Code)
+
+	res = jsonrpc.ParseBody([]byte(`[{"id":1,"method":"eth_chainId"},{"id":2,"method":"eth_chainId"}]`)).ErrorResponses(-32005, "limit exceeded")
+	batch, ok := res.([]*jsonrpc.ErrorResponse)
+	require.True(t, ok)
+	require.Len(t, batch, 2)
+	require.Equal(t, "2", string(batch[1].ID))
+}
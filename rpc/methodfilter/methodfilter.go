@@ -0,0 +1,166 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package methodfilter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+
+	"github.com/evmos/ethermint/rpc/jsonrpc"
+)
+
+const (
+	// ErrCodeMethodNotFound is the JSON-RPC error code of the filtered out methods, the same as
+	// for the methods which don't exist so that they can't be discovered.
+	ErrCodeMethodNotFound = -32601
+)
+
+// Filter decides which JSON-RPC methods are served from glob patterns, a method is served if
+// it matches one of the allow patterns, or there are none, and it matches no deny pattern.
+type Filter struct {
+	allow []string
+	deny  []string
+}
+
+// NewFilter creates a Filter from the allow and deny glob patterns, see path.Match for the syntax.
+func NewFilter(allow, deny []string) *Filter {
+	return &Filter{
+		allow: allow,
+		deny:  deny,
+	}
+}
+
+// Allowed returns true if the method is served.
+func (f *Filter) Allowed(method string) bool {
+	if len(f.allow) > 0 && !matchAny(f.allow, method) {
+		return false
+	}
+	return !matchAny(f.deny, method)
+}
+
+func matchAny(patterns []string, method string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, method); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Filtered returns the error response of the JSON-RPC request if its method is not served,
+// the request is passed through if it's malformed.
+func (f *Filter) Filtered(req jsonrpc.Request) (*jsonrpc.ErrorResponse, bool) {
+	if req.Method == "" || f.Allowed(req.Method) {
+		return nil, false
+	}
+	return MethodNotFoundResponse(req.ID, req.Method), true
+}
+
+// Handler returns a http handler which answers the requests of the methods not served with
+// a method not found error and passes the other ones to next. The elements of a batch request
+// are filtered individually, next serves the batch of the remaining ones.
+func (f *Filter) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r, body, err := jsonrpc.ReadBody(w, r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+			return
+		}
+
+		if !body.Batch {
+			if res, filtered := f.Filtered(body.Requests[0]); filtered {
+				jsonrpc.WriteJSON(w, res)
+				return
+			}
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		var (
+			served  []json.RawMessage
+			blocked []interface{}
+		)
+		for _, req := range body.Requests {
+			if res, filtered := f.Filtered(req); filtered {
+				blocked = append(blocked, res)
+				continue
+			}
+			served = append(served, req.Raw)
+		}
+		switch {
+		case len(blocked) == 0:
+			next.ServeHTTP(w, r)
+			return
+		case len(served) == 0:
+			jsonrpc.WriteJSON(w, blocked)
+			return
+		}
+
+		bz, err := json.Marshal(served)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		r, _ = jsonrpc.WithBody(r, bz)
+
+		buf := &responseBuffer{header: make(http.Header), code: http.StatusOK}
+		next.ServeHTTP(buf, r)
+
+		var responses []json.RawMessage
+		if buf.code != http.StatusOK || json.Unmarshal(buf.body.Bytes(), &responses) != nil {
+			// not a batch response, pass the error through
+			for key, values := range buf.header {
+				w.Header()[key] = values
+			}
+			w.WriteHeader(buf.code)
+			_, _ = w.Write(buf.body.Bytes())
+			return
+		}
+		results := make([]interface{}, 0, len(responses)+len(blocked))
+		for _, res := range responses {
+			results = append(results, res)
+		}
+		jsonrpc.WriteJSON(w, append(results, blocked...))
+	})
+}
+
+// MethodNotFoundResponse returns the error response of a request of a method not served, with
+// the same message as go-ethereum for the methods which don't exist.
+func MethodNotFoundResponse(id json.RawMessage, method string) *jsonrpc.ErrorResponse {
+	return jsonrpc.NewErrorResponse(id, ErrCodeMethodNotFound, fmt.Sprintf("the method %s does not exist/is not available", method))
+}
+
+// responseBuffer is a http.ResponseWriter holding the response in memory.
+type responseBuffer struct {
+	header http.Header
+	code   int
+	body   bytes.Buffer
+}
+
+func (b *responseBuffer) Header() http.Header {
+	return b.header
+}
+
+func (b *responseBuffer) Write(bz []byte) (int, error) {
+	return b.body.Write(bz)
+}
+
+func (b *responseBuffer) WriteHeader(code int) {
+	b.code = code
+}
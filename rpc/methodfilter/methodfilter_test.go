@@ -0,0 +1,95 @@
+package methodfilter_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/rpc/jsonrpc"
+	"github.com/evmos/ethermint/rpc/methodfilter"
+)
+
+func TestFilterAllowed(t *testing.T) {
+	testCases := []struct {
+		name   string
+		allow  []string
+		deny   []string
+		method string
+		exp    bool
+	}{
+		{"no patterns", nil, nil, "debug_setGCPercent", true},
+		{"denied", nil, []string{"debug_set*"}, "debug_setGCPercent", false},
+		{"not denied", nil, []string{"debug_set*"}, "debug_traceTransaction", true},
+		{"allowed", []string{"eth_*", "debug_trace*"}, nil, "debug_traceTransaction", true},
+		{"not allowed", []string{"eth_*", "debug_trace*"}, nil, "debug_writeMemProfile", false},
+		{"deny takes precedence", []string{"debug_*"}, []string{"debug_write*"}, "debug_writeMemProfile", false},
+		{"case sensitive", nil, []string{"debug_setGCPercent"}, "debug_setgcpercent", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.exp, methodfilter.NewFilter(tc.allow, tc.deny).Allowed(tc.method))
+		})
+	}
+}
+
+func TestHandler(t *testing.T) {
+	filter := methodfilter.NewFilter(nil, []string{"debug_set*"})
+	var served []string
+	handler := filter.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		served = append(served, string(body))
+		// echo the ids of the batch as results
+		var reqs []map[string]interface{}
+		if json.Unmarshal(body, &reqs) != nil {
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`))
+			return
+		}
+		res := make([]map[string]interface{}, len(reqs))
+		for i, req := range reqs {
+			res[i] = map[string]interface{}{"jsonrpc": "2.0", "id": req["id"], "result": "0x1"}
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(res))
+	}))
+
+	post := func(body string) []byte {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body)))
+		require.Equal(t, http.StatusOK, rec.Code)
+		return rec.Body.Bytes()
+	}
+
+	// single request
+	var res jsonrpc.ErrorResponse
+	require.NoError(t, json.Unmarshal(post(`{"jsonrpc":"2.0","id":3,"method":"debug_setGCPercent","params":[10]}`), &res))
+	require.Equal(t, methodfilter.ErrCodeMethodNotFound, res.Error.Code)
+	require.Equal(t, "the method debug_setGCPercent does not exist/is not available", res.Error.Message)
+	require.Equal(t, "3", string(res.ID))
+	require.Empty(t, served)
+
+	post(`{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"}`)
+	require.Len(t, served, 1)
+
+	// batch with every element filtered out
+	var batchRes []map[string]interface{}
+	require.NoError(t, json.Unmarshal(post(`[{"id":1,"method":"debug_setHead"},{"id":2,"method":"debug_setGCPercent"}]`), &batchRes))
+	require.Len(t, batchRes, 2)
+	require.Len(t, served, 1)
+
+	// mixed batch, only the allowed elements are served
+	batchRes = nil
+	require.NoError(t, json.Unmarshal(post(`[{"id":1,"method":"eth_blockNumber"},{"id":2,"method":"debug_setGCPercent"},{"id":3,"method":"eth_chainId"}]`), &batchRes))
+	require.Len(t, served, 2)
+	require.JSONEq(t, `[{"id":1,"method":"eth_blockNumber"},{"id":3,"method":"eth_chainId"}]`, served[1])
+	require.Len(t, batchRes, 3)
+	require.Equal(t, "0x1", batchRes[0]["result"])
+	require.Equal(t, "0x1", batchRes[1]["result"])
+	require.Equal(t, float64(2), batchRes[2]["id"])
+	require.NotNil(t, batchRes[2]["error"])
+}
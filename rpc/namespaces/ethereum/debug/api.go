@@ -39,6 +39,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core/state"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/evmos/ethermint/rpc/backend"
 	rpctypes "github.com/evmos/ethermint/rpc/types"
@@ -81,6 +83,18 @@ func (a *API) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfig) (
 	return a.backend.TraceTransaction(hash, config)
 }
 
+// TraceCall lets you trace a given eth_call. It collects the structured logs created
+// during the execution of EVM if the given transaction was added on top of the provided
+// block and returns them as a JSON object.
+func (a *API) TraceCall(
+	args evmtypes.TransactionArgs,
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	config *rpctypes.TraceCallConfig,
+) (interface{}, error) {
+	a.logger.Debug("debug_traceCall", "args", args.String(), "block number or hash", blockNrOrHash)
+	return a.backend.TraceCall(args, blockNrOrHash, config)
+}
+
 // TraceBlockByNumber returns the structured logs created during the execution of
 // EVM and returns them as a JSON object.
 func (a *API) TraceBlockByNumber(height rpctypes.BlockNumber, config *evmtypes.TraceConfig) ([]*evmtypes.TxTraceResult, error) {
@@ -321,6 +335,50 @@ func (a *API) GetBlockRlp(number uint64) (hexutil.Bytes, error) {
 	return rlp.EncodeToBytes(block)
 }
 
+// GetRawHeader retrieves the RLP encoding of a single header.
+func (a *API) GetRawHeader(blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error) {
+	a.logger.Debug("debug_getRawHeader", "block number or hash", blockNrOrHash)
+	block, err := a.ethBlock(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return rlp.EncodeToBytes(block.Header())
+}
+
+// GetRawBlock retrieves the RLP encoding of a single block.
+func (a *API) GetRawBlock(blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error) {
+	a.logger.Debug("debug_getRawBlock", "block number or hash", blockNrOrHash)
+	block, err := a.ethBlock(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return rlp.EncodeToBytes(block)
+}
+
+// GetRawReceipts retrieves the binary-encoded receipts of a single block.
+func (a *API) GetRawReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]hexutil.Bytes, error) {
+	a.logger.Debug("debug_getRawReceipts", "block number or hash", blockNrOrHash)
+	return a.backend.GetRawReceipts(blockNrOrHash)
+}
+
+// GetRawTransaction returns the bytes of the transaction for the given hash.
+func (a *API) GetRawTransaction(hash common.Hash) (hexutil.Bytes, error) {
+	a.logger.Debug("debug_getRawTransaction", "hash", hash)
+	return a.backend.GetRawTransaction(hash)
+}
+
+// ethBlock returns the ethereum block identified by number or hash.
+func (a *API) ethBlock(blockNrOrHash rpctypes.BlockNumberOrHash) (*ethtypes.Block, error) {
+	blockNum, err := a.backend.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.backend.EthBlockByNumber(blockNum)
+}
+
 // PrintBlock retrieves a block and returns its pretty printed form.
 func (a *API) PrintBlock(number uint64) (string, error) {
 	block, err := a.backend.EthBlockByNumber(rpctypes.BlockNumber(number))
@@ -341,9 +399,56 @@ func (a *API) SeedHash(number uint64) (string, error) {
 	return fmt.Sprintf("0x%x", ethash.SeedHash(number)), nil
 }
 
-// IntermediateRoots executes a block, and returns a list
-// of intermediate roots: the stateroot after each transaction.
+// IntermediateRoots executes a block, and returns a list of intermediate roots: the
+// state commitment after each transaction. Unlike geth, the roots are not state trie
+// roots, they chain the commitments of the EVM accounts and storage written by each
+// transaction and of the balances changed by its fee and refund.
 func (a *API) IntermediateRoots(hash common.Hash, _ *evmtypes.TraceConfig) ([]common.Hash, error) {
 	a.logger.Debug("debug_intermediateRoots", "hash", hash)
-	return ([]common.Hash)(nil), nil
+	// Get Tendermint Block
+	resBlock, err := a.backend.TendermintBlockByHash(hash)
+	if err != nil {
+		a.logger.Debug("get block failed", "hash", hash.Hex(), "error", err.Error())
+		return nil, err
+	}
+
+	if resBlock == nil || resBlock.Block == nil {
+		a.logger.Debug("block not found", "hash", hash.Hex())
+		return nil, errors.New("block not found")
+	}
+
+	if resBlock.Block.Height == 0 {
+		return nil, errors.New("genesis is not traceable")
+	}
+
+	return a.backend.IntermediateRoots(rpctypes.BlockNumber(resBlock.Block.Height), resBlock)
+}
+
+// StorageRangeAt returns the storage of the contract at the given transaction index of
+// the block, starting from the given storage key. Unlike geth, which iterates the storage
+// by the hash of the keys, the entries are ordered by their plain key, and the start and
+// next keys are plain storage keys.
+func (a *API) StorageRangeAt(
+	blockHash common.Hash,
+	txIndex int,
+	contractAddress common.Address,
+	keyStart hexutil.Bytes,
+	maxResult int,
+) (rpctypes.StorageRangeResult, error) {
+	a.logger.Debug("debug_storageRangeAt", "hash", blockHash, "index", txIndex, "address", contractAddress)
+	return a.backend.StorageRangeAt(blockHash, txIndex, contractAddress, keyStart, maxResult)
+}
+
+// AccountRange enumerates the accounts of the given block from the given start address,
+// the incompletes flag is ignored as the accounts are keyed by address. Unlike geth, which
+// iterates the accounts by the hash of their address, the accounts are ordered by their
+// plain address, and the start and next keys are addresses.
+func (a *API) AccountRange(
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	start hexutil.Bytes,
+	maxResults int,
+	nocode, nostorage, _ bool,
+) (state.IteratorDump, error) {
+	a.logger.Debug("debug_accountRange", "block", blockNrOrHash, "start", start, "max", maxResults)
+	return a.backend.AccountRange(blockNrOrHash, start, maxResults, nocode, nostorage)
 }
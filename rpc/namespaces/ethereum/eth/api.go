@@ -58,9 +58,9 @@ type EthereumAPI interface {
 	GetTransactionByHash(hash common.Hash) (*rpctypes.RPCTransaction, error)
 	GetTransactionCount(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (*hexutil.Uint64, error)
 	GetTransactionReceipt(hash common.Hash) (map[string]interface{}, error)
+	GetBlockReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]map[string]interface{}, error)
 	GetTransactionByBlockHashAndIndex(hash common.Hash, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
 	GetTransactionByBlockNumberAndIndex(blockNum rpctypes.BlockNumber, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
-	// eth_getBlockReceipts
 
 	// Writing Transactions
 	//
@@ -84,14 +84,29 @@ type EthereumAPI interface {
 	//
 	// Allows developers to read data from the blockchain which includes executing
 	// smart contracts. However, no data is published to the Ethereum network.
-	Call(args evmtypes.TransactionArgs, blockNrOrHash rpctypes.BlockNumberOrHash, _ *rpctypes.StateOverride) (hexutil.Bytes, error)
+	Call(
+		args evmtypes.TransactionArgs,
+		blockNrOrHash rpctypes.BlockNumberOrHash,
+		overrides *rpctypes.StateOverride,
+		blockOverrides *rpctypes.BlockOverrides,
+	) (hexutil.Bytes, error)
+	SimulateV1(opts rpctypes.SimOpts, blockNrOrHash *rpctypes.BlockNumberOrHash) ([]evmtypes.SimBlockResult, error)
+	CallMany(
+		args []evmtypes.TransactionArgs,
+		blockNrOrHash *rpctypes.BlockNumberOrHash,
+		overrides *rpctypes.StateOverride,
+	) ([]evmtypes.SimCallResult, error)
+	CreateAccessList(
+		args evmtypes.TransactionArgs,
+		blockNrOrHash *rpctypes.BlockNumberOrHash,
+	) (*rpctypes.AccessListResult, error)
 
 	// Chain Information
 	//
 	// Returns information on the Ethereum network and internal settings.
 	ProtocolVersion() hexutil.Uint
 	GasPrice() (*hexutil.Big, error)
-	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error)
+	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber, overrides *rpctypes.StateOverride) (hexutil.Uint64, error)
 	FeeHistory(blockCount rpc.DecimalOrHex, lastBlock rpc.BlockNumber, rewardPercentiles []float64) (*rpctypes.FeeHistoryResult, error)
 	MaxPriorityFeePerGas() (*hexutil.Big, error)
 	ChainId() (*hexutil.Big, error)
@@ -196,6 +211,12 @@ func (e *PublicAPI) GetTransactionReceipt(hash common.Hash) (map[string]interfac
 	return e.backend.GetTransactionReceipt(hash)
 }
 
+// GetBlockReceipts returns the receipts of all the transactions of the given block.
+func (e *PublicAPI) GetBlockReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]map[string]interface{}, error) {
+	e.logger.Debug("eth_getBlockReceipts", "block number or hash", blockNrOrHash)
+	return e.backend.GetBlockReceipts(blockNrOrHash)
+}
+
 // GetBlockTransactionCountByHash returns the number of transactions in the block identified by hash.
 func (e *PublicAPI) GetBlockTransactionCountByHash(hash common.Hash) *hexutil.Uint {
 	e.logger.Debug("eth_getBlockTransactionCountByHash", "hash", hash.Hex())
@@ -280,7 +301,8 @@ func (e *PublicAPI) GetProof(address common.Address,
 // Call performs a raw contract call.
 func (e *PublicAPI) Call(args evmtypes.TransactionArgs,
 	blockNrOrHash rpctypes.BlockNumberOrHash,
-	_ *rpctypes.StateOverride,
+	overrides *rpctypes.StateOverride,
+	blockOverrides *rpctypes.BlockOverrides,
 ) (hexutil.Bytes, error) {
 	e.logger.Debug("eth_call", "args", args.String(), "block number or hash", blockNrOrHash)
 
@@ -288,7 +310,7 @@ func (e *PublicAPI) Call(args evmtypes.TransactionArgs,
 	if err != nil {
 		return nil, err
 	}
-	data, err := e.backend.DoCall(args, blockNum)
+	data, err := e.backend.DoCall(args, blockNum, overrides, blockOverrides)
 	if err != nil {
 		return []byte{}, err
 	}
@@ -296,6 +318,61 @@ func (e *PublicAPI) Call(args evmtypes.TransactionArgs,
 	return (hexutil.Bytes)(data.Ret), nil
 }
 
+// SimulateV1 executes series of blocks of calls on top of the given block, the
+// state changes of each call are visible to the following ones.
+func (e *PublicAPI) SimulateV1(
+	opts rpctypes.SimOpts,
+	blockNrOrHash *rpctypes.BlockNumberOrHash,
+) ([]evmtypes.SimBlockResult, error) {
+	e.logger.Debug("eth_simulateV1", "blocks", len(opts.BlockStateCalls), "block number or hash", blockNrOrHash)
+
+	blockNum, err := e.blockNumberOrLatest(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	return e.backend.SimulateV1(opts, blockNum)
+}
+
+// CallMany executes the calls in order on top of the given block, the state
+// changes of each call are visible to the following ones.
+func (e *PublicAPI) CallMany(
+	args []evmtypes.TransactionArgs,
+	blockNrOrHash *rpctypes.BlockNumberOrHash,
+	overrides *rpctypes.StateOverride,
+) ([]evmtypes.SimCallResult, error) {
+	e.logger.Debug("eth_callMany", "calls", len(args), "block number or hash", blockNrOrHash)
+
+	blockNum, err := e.blockNumberOrLatest(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	return e.backend.CallMany(args, blockNum, overrides)
+}
+
+// CreateAccessList creates an EIP-2930 access list for the given transaction,
+// it is computed on top of the given block, defaults to the latest block.
+func (e *PublicAPI) CreateAccessList(
+	args evmtypes.TransactionArgs,
+	blockNrOrHash *rpctypes.BlockNumberOrHash,
+) (*rpctypes.AccessListResult, error) {
+	e.logger.Debug("eth_createAccessList", "args", args.String(), "block number or hash", blockNrOrHash)
+
+	blockNum, err := e.blockNumberOrLatest(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	return e.backend.CreateAccessList(args, blockNum)
+}
+
+// blockNumberOrLatest resolves the optional block number or hash, defaults to the latest block.
+func (e *PublicAPI) blockNumberOrLatest(blockNrOrHash *rpctypes.BlockNumberOrHash) (rpctypes.BlockNumber, error) {
+	if blockNrOrHash == nil {
+		latest := rpctypes.EthLatestBlockNumber
+		blockNrOrHash = &rpctypes.BlockNumberOrHash{BlockNumber: &latest}
+	}
+	return e.backend.BlockNumberFromTendermint(*blockNrOrHash)
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 ///                           Event Logs													          ///
 ///////////////////////////////////////////////////////////////////////////////
@@ -318,9 +395,13 @@ func (e *PublicAPI) GasPrice() (*hexutil.Big, error) {
 }
 
 // EstimateGas returns an estimate of gas usage for the given smart contract call.
-func (e *PublicAPI) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error) {
+func (e *PublicAPI) EstimateGas(
+	args evmtypes.TransactionArgs,
+	blockNrOptional *rpctypes.BlockNumber,
+	overrides *rpctypes.StateOverride,
+) (hexutil.Uint64, error) {
 	e.logger.Debug("eth_estimateGas")
-	return e.backend.EstimateGas(args, blockNrOptional)
+	return e.backend.EstimateGas(args, blockNrOptional, overrides)
 }
 
 func (e *PublicAPI) FeeHistory(blockCount rpc.DecimalOrHex,
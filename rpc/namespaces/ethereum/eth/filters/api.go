@@ -35,6 +35,7 @@ import (
 	"github.com/ethereum/go-ethereum/eth/filters"
 	"github.com/ethereum/go-ethereum/rpc"
 
+	ethermint "github.com/evmos/ethermint/types"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 )
 
@@ -58,6 +59,7 @@ type Backend interface {
 	TendermintBlockResultByNumber(height *int64) (*coretypes.ResultBlockResults, error)
 	GetLogs(blockHash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByHeight(*int64) ([][]*ethtypes.Log, error)
+	GetLogsFromIndex(from, to int64, addresses []common.Address, topics [][]common.Hash, limit int) ([]*ethtypes.Log, int64, error)
 	BlockBloom(blockRes *coretypes.ResultBlockResults) (ethtypes.Bloom, error)
 
 	BloomStatus() (uint64, uint64)
@@ -206,12 +208,21 @@ func (api *PublicFilterAPI) NewPendingTransactionFilter() rpc.ID {
 
 // NewPendingTransactions creates a subscription that is triggered each time a transaction
 // enters the transaction pool and was signed from one of the transactions this nodes manages.
-func (api *PublicFilterAPI) NewPendingTransactions(ctx context.Context) (*rpc.Subscription, error) {
+// The full transactions are sent instead of the hashes if fullTx is true, and only the
+// transactions matching the criteria are sent if it's provided.
+func (api *PublicFilterAPI) NewPendingTransactions(ctx context.Context, fullTx *bool, criteria *PendingTxCriteria) (*rpc.Subscription, error) {
 	notifier, supported := rpc.NotifierFromContext(ctx)
 	if !supported {
 		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
 	}
 
+	chainID, err := ethermint.ParseChainID(api.clientCtx.ChainID)
+	if err != nil {
+		return nil, err
+	}
+
+	signer := ethtypes.LatestSignerForChainID(chainID)
+
 	rpcSub := notifier.CreateSubscription()
 
 	ctx, cancelFn := context.WithTimeout(context.Background(), deadline)
@@ -250,10 +261,22 @@ func (api *PublicFilterAPI) NewPendingTransactions(ctx context.Context) (*rpc.Su
 				}
 
 				for _, msg := range tx.GetMsgs() {
-					ethTx, ok := msg.(*evmtypes.MsgEthereumTx)
-					if ok {
-						_ = notifier.Notify(rpcSub.ID, ethTx.AsTransaction().Hash())
+					ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+					if !ok {
+						continue
+					}
+
+					ethTx := ethMsg.AsTransaction()
+					if !criteria.Matches(ethTx, signer) {
+						continue
+					}
+
+					result, err := PendingTxResult(ethTx, fullTx != nil && *fullTx, chainID)
+					if err != nil {
+						api.logger.Debug("fail to build pending tx", "error", err.Error())
+						continue
 					}
+					_ = notifier.Notify(rpcSub.ID, result)
 				}
 			case <-rpcSub.Err():
 				pendingTxSub.Unsubscribe(api.events)
@@ -103,6 +103,8 @@ func newFilter(logger log.Logger, backend Backend, criteria filters.FilterCriter
 
 const (
 	maxToOverhang = 600
+	// maxFetchWorkers is the max number of block results fetched concurrently by a range filter
+	maxFetchWorkers = 8
 )
 
 // Logs searches the blockchain for matching log entries, returning all from the
@@ -117,11 +119,13 @@ func (f *Filter) Logs(ctx context.Context, logLimit int, blockLimit int64) ([]*e
 		if err != nil {
 			return nil, fmt.Errorf("failed to fetch header by hash %s: %w", f.criteria.BlockHash, err)
 		}
+		if resBlock == nil || resBlock.Block == nil {
+			return nil, fmt.Errorf("block %s not found", f.criteria.BlockHash.Hex())
+		}
 
 		blockRes, err := f.backend.TendermintBlockResultByNumber(&resBlock.Block.Height)
 		if err != nil {
-			f.logger.Debug("failed to fetch block result from Tendermint", "height", resBlock.Block.Height, "error", err.Error())
-			return nil, nil
+			return nil, errors.Wrapf(err, "failed to fetch block result from Tendermint, height %d", resBlock.Block.Height)
 		}
 
 		bloom, err := f.backend.BlockBloom(blockRes)
@@ -167,34 +171,111 @@ func (f *Filter) Logs(ctx context.Context, logLimit int, blockLimit int64) ([]*e
 	}
 
 	from := f.criteria.FromBlock.Int64()
-	to := f.criteria.ToBlock.Int64()
+	// the blocks after head don't exist yet
+	to := min(f.criteria.ToBlock.Int64(), head)
 
-	for height := from; height <= to; height++ {
-		blockRes, err := f.backend.TendermintBlockResultByNumber(&height)
-		if err != nil {
-			f.logger.Debug("failed to fetch block result from Tendermint", "height", height, "error", err.Error())
-			return nil, nil
-		}
+	// the indexed prefix of the range is served by the log index, the indexer may lag
+	// behind the head so the tail is fetched from the block results
+	indexed, indexedTo, err := f.backend.GetLogsFromIndex(from, to, f.criteria.Addresses, f.criteria.Topics, logLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch logs from index: %w", err)
+	}
+	// check logs limit
+	if len(indexed) > logLimit {
+		return nil, fmt.Errorf("query returned more than %d results", logLimit)
+	}
+	logs = append(logs, indexed...)
 
-		bloom, err := f.backend.BlockBloom(blockRes)
-		if err != nil {
-			return nil, err
+	return f.rangeLogs(ctx, logs, indexedTo+1, to, logLimit)
+}
+
+// blockLogsResult is the result of fetching the logs of a block
+type blockLogsResult struct {
+	logs []*ethtypes.Log
+	err  error
+}
+
+// rangeLogs fetches the block results of the range concurrently with a bounded number of
+// workers and appends the matching logs in block order to the given logs. It stops at the
+// first error, when the logs limit is exceeded or when the context is cancelled.
+func (f *Filter) rangeLogs(ctx context.Context, logs []*ethtypes.Log, from, to int64, logLimit int) ([]*ethtypes.Log, error) {
+	if from > to {
+		return logs, nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// results are buffered so the workers never block after an early return
+	results := make([]chan blockLogsResult, to-from+1)
+	for i := range results {
+		results[i] = make(chan blockLogsResult, 1)
+	}
+
+	heights := make(chan int64)
+	go func() {
+		defer close(heights)
+		for height := from; height <= to; height++ {
+			select {
+			case heights <- height:
+			case <-ctx.Done():
+				return
+			}
 		}
+	}()
 
-		filtered, err := f.blockLogs(blockRes, bloom)
-		if err != nil {
-			return nil, errors.Wrapf(err, "failed to fetch block by number %d", height)
+	for i := 0; i < min(maxFetchWorkers, len(results)); i++ {
+		go func() {
+			for height := range heights {
+				filtered, err := f.fetchBlockLogs(ctx, height)
+				results[height-from] <- blockLogsResult{filtered, err}
+			}
+		}()
+	}
+
+	for _, resCh := range results {
+		var res blockLogsResult
+		select {
+		case res = <-resCh:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		if res.err != nil {
+			return nil, res.err
 		}
 
 		// check logs limit
-		if len(logs)+len(filtered) > logLimit {
+		if len(logs)+len(res.logs) > logLimit {
 			return nil, fmt.Errorf("query returned more than %d results", logLimit)
 		}
-		logs = append(logs, filtered...)
+		logs = append(logs, res.logs...)
 	}
 	return logs, nil
 }
 
+// fetchBlockLogs returns the logs matching the filter criteria within the block at height.
+func (f *Filter) fetchBlockLogs(ctx context.Context, height int64) ([]*ethtypes.Log, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	blockRes, err := f.backend.TendermintBlockResultByNumber(&height)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch block result from Tendermint, height %d", height)
+	}
+
+	bloom, err := f.backend.BlockBloom(blockRes)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered, err := f.blockLogs(blockRes, bloom)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch block by number %d", height)
+	}
+	return filtered, nil
+}
+
 // blockLogs returns the logs matching the filter criteria within a single block.
 func (f *Filter) blockLogs(blockRes *tmrpctypes.ResultBlockResults, bloom ethtypes.Bloom) ([]*ethtypes.Log, error) {
 	if !bloomFilter(bloom, f.criteria.Addresses, f.criteria.Topics) {
@@ -0,0 +1,200 @@
+package filters
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/rpc/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+var errBlockResults = errors.New("block results not available")
+
+// fakeBackend serves one log per block up to the head, the block results of the
+// failing heights can't be fetched. The logs of the blocks up to indexedTo are
+// served by the log index. The hashes of the blocks are their heights.
+type fakeBackend struct {
+	head      int64
+	indexedTo int64
+	address   common.Address
+	failing   map[int64]bool
+}
+
+func (b *fakeBackend) blockLog(height int64) *ethtypes.Log {
+	return &ethtypes.Log{
+		Address:     b.address,
+		Topics:      []common.Hash{},
+		BlockNumber: uint64(height),
+	}
+}
+
+func (b *fakeBackend) GetBlockByNumber(types.BlockNumber, bool) (map[string]interface{}, error) {
+	return nil, nil
+}
+
+func (b *fakeBackend) HeaderByNumber(types.BlockNumber) (*ethtypes.Header, error) {
+	return &ethtypes.Header{Number: big.NewInt(b.head)}, nil
+}
+
+func (b *fakeBackend) HeaderByHash(common.Hash) (*ethtypes.Header, error) {
+	return nil, nil
+}
+
+func (b *fakeBackend) TendermintBlockByHash(hash common.Hash) (*coretypes.ResultBlock, error) {
+	height := hash.Big().Int64()
+	if height > b.head {
+		return nil, nil
+	}
+	return &coretypes.ResultBlock{Block: &tmtypes.Block{Header: tmtypes.Header{Height: height}}}, nil
+}
+
+func (b *fakeBackend) TendermintBlockResultByNumber(height *int64) (*coretypes.ResultBlockResults, error) {
+	if b.failing[*height] {
+		return nil, errBlockResults
+	}
+	// the lower blocks are the slowest, so that the workers complete out of order
+	time.Sleep(time.Duration(b.head-*height) * time.Millisecond)
+
+	bz, err := json.Marshal(evmtypes.NewLogFromEth(b.blockLog(*height)))
+	if err != nil {
+		return nil, err
+	}
+	return &coretypes.ResultBlockResults{
+		Height: *height,
+		TxsResults: []*abci.ResponseDeliverTx{{
+			Events: []abci.Event{{
+				Type:       evmtypes.EventTypeTxLog,
+				Attributes: []abci.EventAttribute{{Key: evmtypes.AttributeKeyTxLog, Value: string(bz)}},
+			}},
+		}},
+	}, nil
+}
+
+func (b *fakeBackend) GetLogs(common.Hash) ([][]*ethtypes.Log, error) {
+	return nil, nil
+}
+
+func (b *fakeBackend) GetLogsByHeight(*int64) ([][]*ethtypes.Log, error) {
+	return nil, nil
+}
+
+func (b *fakeBackend) GetLogsFromIndex(from, to int64, _ []common.Address, _ [][]common.Hash, limit int) ([]*ethtypes.Log, int64, error) {
+	until := min(to, b.indexedTo)
+	if until < from {
+		return nil, from - 1, nil
+	}
+	var logs []*ethtypes.Log
+	for height := from; height <= until && len(logs) <= limit; height++ {
+		logs = append(logs, b.blockLog(height))
+	}
+	return logs, until, nil
+}
+
+func (b *fakeBackend) BlockBloom(*coretypes.ResultBlockResults) (ethtypes.Bloom, error) {
+	return ethtypes.BytesToBloom(ethtypes.LogsBloom([]*ethtypes.Log{{Address: b.address}})), nil
+}
+
+func (b *fakeBackend) BloomStatus() (uint64, uint64) {
+	return 4096, 0
+}
+
+func (b *fakeBackend) RPCFilterCap() int32 {
+	return 100
+}
+
+func (b *fakeBackend) RPCLogsCap() int32 {
+	return 10000
+}
+
+func (b *fakeBackend) RPCBlockRangeCap() int32 {
+	return 10000
+}
+
+func TestFilterRangeLogs(t *testing.T) {
+	address := common.BigToAddress(common.Big1)
+
+	testCases := []struct {
+		name      string
+		failing   map[int64]bool
+		indexedTo int64
+		from, to  int64
+		logLimit  int
+		expLogs   int64
+		expErrMsg string
+	}{
+		{"logs are ordered across workers", nil, 0, 1, 30, 100, 30, ""},
+		{"single block", nil, 0, 5, 5, 100, 1, ""},
+		{"range beyond head", nil, 0, 25, 40, 100, 6, ""},
+		{"logs limit reached", nil, 0, 1, 30, 30, 30, ""},
+		{"logs limit exceeded", nil, 0, 1, 30, 10, 0, "query returned more than 10 results"},
+		{"block results error", map[int64]bool{17: true}, 0, 1, 30, 100, 0, "height 17"},
+		{"indexed prefix is not fetched", map[int64]bool{5: true}, 20, 1, 30, 100, 30, ""},
+		{"fully indexed range", map[int64]bool{5: true}, 30, 1, 30, 100, 30, ""},
+		{"unindexed tail is fetched", map[int64]bool{25: true}, 20, 1, 30, 100, 0, "height 25"},
+		{"logs limit exceeded by the indexed prefix", nil, 20, 1, 30, 10, 0, "query returned more than 10 results"},
+		{"logs limit exceeded across the prefix and the tail", nil, 20, 1, 30, 25, 0, "query returned more than 25 results"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			backend := &fakeBackend{head: 30, indexedTo: tc.indexedTo, address: address, failing: tc.failing}
+			filter := NewRangeFilter(log.NewNopLogger(), backend, tc.from, tc.to, []common.Address{address}, nil)
+
+			logs, err := filter.Logs(context.Background(), tc.logLimit, 1000)
+			if tc.expErrMsg != "" {
+				require.ErrorContains(t, err, tc.expErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, logs, int(tc.expLogs))
+			for i, log := range logs {
+				require.Equal(t, uint64(tc.from)+uint64(i), log.BlockNumber)
+			}
+		})
+	}
+}
+
+func TestFilterRangeLogsCancelled(t *testing.T) {
+	backend := &fakeBackend{head: 30, address: common.BigToAddress(common.Big1)}
+	filter := NewRangeFilter(log.NewNopLogger(), backend, 1, 30, nil, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := filter.Logs(ctx, 100, 1000)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestFilterBlockHashLogs(t *testing.T) {
+	address := common.BigToAddress(common.Big1)
+	blockHash := common.BigToHash(big.NewInt(7))
+
+	backend := &fakeBackend{head: 30, address: address}
+	filter := NewBlockFilter(log.NewNopLogger(), backend, filters.FilterCriteria{BlockHash: &blockHash})
+	logs, err := filter.Logs(context.Background(), 100, 1000)
+	require.NoError(t, err)
+	require.Equal(t, []*ethtypes.Log{backend.blockLog(7)}, logs)
+
+	// the error fetching the block results is returned
+	backend.failing = map[int64]bool{7: true}
+	_, err = filter.Logs(context.Background(), 100, 1000)
+	require.ErrorIs(t, err, errBlockResults)
+
+	// an unknown block hash is an error
+	unknownHash := common.BigToHash(big.NewInt(31))
+	filter = NewBlockFilter(log.NewNopLogger(), backend, filters.FilterCriteria{BlockHash: &unknownHash})
+	_, err = filter.Logs(context.Background(), 100, 1000)
+	require.ErrorContains(t, err, "not found")
+}
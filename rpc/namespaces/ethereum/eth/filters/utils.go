@@ -20,6 +20,8 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/ethermint/rpc/types"
 )
 
 // FilterLogs creates a slice of logs matching the given criteria.
@@ -62,6 +64,42 @@ Logs:
 	return ret
 }
 
+// PendingTxCriteria restricts a pending transactions subscription to the txs sent from one of
+// the From addresses or sent to one of the To addresses, an empty criteria matches any tx.
+type PendingTxCriteria struct {
+	From []common.Address `json:"from"`
+	To   []common.Address `json:"to"`
+}
+
+// Matches returns true if the tx is sent from or to one of the addresses of the criteria.
+// The sender is only recovered from the signature if the criteria has From addresses.
+func (c *PendingTxCriteria) Matches(tx *ethtypes.Transaction, signer ethtypes.Signer) bool {
+	if c == nil || (len(c.From) == 0 && len(c.To) == 0) {
+		return true
+	}
+	if to := tx.To(); to != nil && includes(c.To, *to) {
+		return true
+	}
+	if len(c.From) == 0 {
+		return false
+	}
+	from, err := ethtypes.Sender(signer, tx)
+	if err != nil {
+		return false
+	}
+	return includes(c.From, from)
+}
+
+// PendingTxResult returns the notification of a pending transactions subscription, which is
+// the full RPC transaction if fullTx is set, otherwise the tx hash.
+func PendingTxResult(tx *ethtypes.Transaction, fullTx bool, chainID *big.Int) (interface{}, error) {
+	if !fullTx {
+		return tx.Hash(), nil
+	}
+	// use zero block values since it's not included in a block yet
+	return types.NewRPCTransaction(tx, common.Hash{}, 0, 0, nil, chainID)
+}
+
 func includes(addresses []common.Address, a common.Address) bool {
 	for _, addr := range addresses {
 		if addr == a {
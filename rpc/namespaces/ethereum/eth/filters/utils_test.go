@@ -0,0 +1,87 @@
+package filters
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/rpc/types"
+)
+
+func TestPendingTxCriteriaMatches(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	to := common.BigToAddress(big.NewInt(2))
+	other := common.BigToAddress(big.NewInt(3))
+	// the sender is recovered from the signature
+	signer := ethtypes.LatestSignerForChainID(big.NewInt(9000))
+	tx, err := ethtypes.SignNewTx(key, signer, &ethtypes.LegacyTx{To: &to, Gas: 21000})
+	require.NoError(t, err)
+	creation, err := ethtypes.SignNewTx(key, signer, &ethtypes.LegacyTx{Gas: 53000})
+	require.NoError(t, err)
+	unsigned := ethtypes.NewTx(&ethtypes.LegacyTx{To: &to})
+
+	testCases := []struct {
+		name     string
+		criteria *PendingTxCriteria
+		tx       *ethtypes.Transaction
+		expMatch bool
+	}{
+		{"nil criteria", nil, tx, true},
+		{"empty criteria", &PendingTxCriteria{}, tx, true},
+		{"from matches", &PendingTxCriteria{From: []common.Address{other, from}}, tx, true},
+		{"from doesn't match", &PendingTxCriteria{From: []common.Address{other}}, tx, false},
+		{"to matches", &PendingTxCriteria{To: []common.Address{to}}, tx, true},
+		{"to doesn't match", &PendingTxCriteria{To: []common.Address{other}}, tx, false},
+		{"from or to matches", &PendingTxCriteria{From: []common.Address{other}, To: []common.Address{to}}, tx, true},
+		{"contract creation doesn't match to", &PendingTxCriteria{To: []common.Address{to}}, creation, false},
+		{"contract creation matches from", &PendingTxCriteria{From: []common.Address{from}, To: []common.Address{to}}, creation, true},
+		{"unsigned tx doesn't match from", &PendingTxCriteria{From: []common.Address{{}}}, unsigned, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expMatch, tc.criteria.Matches(tc.tx, signer))
+		})
+	}
+}
+
+func TestPendingTxResult(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	chainID := big.NewInt(9000)
+	to := common.BigToAddress(big.NewInt(2))
+	tx, err := ethtypes.SignNewTx(key, ethtypes.LatestSignerForChainID(chainID), &ethtypes.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     1,
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(10),
+		Gas:       21000,
+		To:        &to,
+		Value:     big.NewInt(100),
+	})
+	require.NoError(t, err)
+
+	// the tx hash is sent by default
+	result, err := PendingTxResult(tx, false, chainID)
+	require.NoError(t, err)
+	require.Equal(t, tx.Hash(), result)
+
+	// the full tx isn't in a block yet
+	result, err = PendingTxResult(tx, true, chainID)
+	require.NoError(t, err)
+	rpcTx, ok := result.(*types.RPCTransaction)
+	require.True(t, ok)
+	require.Equal(t, tx.Hash(), rpcTx.Hash)
+	require.Equal(t, crypto.PubkeyToAddress(key.PublicKey), rpcTx.From)
+	require.Equal(t, &to, rpcTx.To)
+	require.Equal(t, uint64(1), uint64(rpcTx.Nonce))
+	require.Nil(t, rpcTx.BlockHash)
+	require.Nil(t, rpcTx.BlockNumber)
+	require.Nil(t, rpcTx.TransactionIndex)
+}
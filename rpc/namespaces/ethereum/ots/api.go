@@ -0,0 +1,543 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package ots
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/evmos/ethermint/rpc/backend"
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+	evmtracers "github.com/evmos/ethermint/x/evm/tracers"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// apiLevel is the Otterscan API level implemented by the namespace
+const apiLevel = 8
+
+// TransactionsWithReceipts is a page of the txs of an address along with their receipts,
+// the receipts contain the timestamp of their block.
+type TransactionsWithReceipts struct {
+	Txs       []*rpctypes.RPCTransaction `json:"txs"`
+	Receipts  []map[string]interface{}   `json:"receipts"`
+	FirstPage bool                       `json:"firstPage"`
+	LastPage  bool                       `json:"lastPage"`
+}
+
+// ContractCreator is the creator of a contract and the tx creating it
+type ContractCreator struct {
+	Tx      common.Hash    `json:"hash"`
+	Creator common.Address `json:"creator"`
+}
+
+// API is the collection of the Otterscan APIs, they are built on the tracing queries
+// and on the address tx lists of the custom tx indexer.
+type API struct {
+	ctx     *server.Context
+	logger  log.Logger
+	backend backend.EVMBackend
+}
+
+// NewAPI creates a new API definition for the Otterscan methods.
+func NewAPI(
+	ctx *server.Context,
+	backend backend.EVMBackend,
+) *API {
+	return &API{
+		ctx:     ctx,
+		logger:  ctx.Logger.With("module", "ots"),
+		backend: backend,
+	}
+}
+
+// GetApiLevel returns the Otterscan API level implemented by the node.
+func (a *API) GetApiLevel() uint64 { //nolint: stylecheck, revive
+	a.logger.Debug("ots_getApiLevel")
+	return apiLevel
+}
+
+// HasCode returns true if the address holds contract code at the block.
+func (a *API) HasCode(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (bool, error) {
+	a.logger.Debug("ots_hasCode", "address", address, "block number or hash", blockNrOrHash)
+	code, err := a.backend.GetCode(address, blockNrOrHash)
+	if err != nil {
+		return false, err
+	}
+	return len(code) > 0, nil
+}
+
+// GetBlockDetails returns the header fields of the block along with its transaction
+// count, issuance and total fees, it's nil if the block doesn't exist.
+func (a *API) GetBlockDetails(blockNr rpctypes.BlockNumber) (map[string]interface{}, error) {
+	a.logger.Debug("ots_getBlockDetails", "number", blockNr)
+	block, err := a.backend.GetBlockByNumber(blockNr, true)
+	if err != nil {
+		return nil, err
+	}
+	return a.blockDetails(block)
+}
+
+// GetBlockDetailsByHash returns the header fields of the block along with its
+// transaction count, issuance and total fees, it's nil if the block doesn't exist.
+func (a *API) GetBlockDetailsByHash(hash common.Hash) (map[string]interface{}, error) {
+	a.logger.Debug("ots_getBlockDetailsByHash", "hash", hash)
+	block, err := a.backend.GetBlockByHash(hash, true)
+	if err != nil {
+		return nil, err
+	}
+	return a.blockDetails(block)
+}
+
+// GetBlockTransactions returns a page of the transactions of the block along with their
+// receipts, the pages are numbered from the last transaction of the block. The input of
+// the transactions is cropped to the method selector, and the logs of the receipts are
+// omitted.
+func (a *API) GetBlockTransactions(blockNr rpctypes.BlockNumber, pageNumber, pageSize uint8) (map[string]interface{}, error) {
+	a.logger.Debug("ots_getBlockTransactions", "number", blockNr, "page", pageNumber, "pageSize", pageSize)
+	block, err := a.backend.GetBlockByNumber(blockNr, true)
+	if err != nil || block == nil {
+		return nil, err
+	}
+	receipts, err := a.blockReceipts(block)
+	if err != nil {
+		return nil, err
+	}
+	txs, ok := block["transactions"].([]interface{})
+	if !ok || len(txs) != len(receipts) {
+		return nil, fmt.Errorf("invalid transactions of block %d", blockNr)
+	}
+
+	for _, tx := range txs {
+		if rpcTx, ok := tx.(*rpctypes.RPCTransaction); ok && len(rpcTx.Input) > 4 {
+			rpcTx.Input = rpcTx.Input[:4]
+		}
+	}
+	for _, receipt := range receipts {
+		receipt["logs"] = nil
+		receipt["logsBloom"] = nil
+	}
+
+	end := len(txs) - int(pageNumber)*int(pageSize)
+	if end < 0 {
+		end = 0
+	}
+	start := end - int(pageSize)
+	if start < 0 {
+		start = 0
+	}
+	block["transactions"] = txs[start:end]
+	return map[string]interface{}{
+		"fullblock": block,
+		"receipts":  receipts[start:end],
+	}, nil
+}
+
+// GetTransactionBySenderAndNonce returns the hash of the transaction of the sender with
+// the nonce, it's nil if there is none. The block of the transaction is found by a
+// binary search of the nonce of the sender over the heights.
+func (a *API) GetTransactionBySenderAndNonce(sender common.Address, nonce uint64) (*common.Hash, error) {
+	a.logger.Debug("ots_getTransactionBySenderAndNonce", "sender", sender, "nonce", nonce)
+	latest, err := a.backend.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	// nonceAfter returns the nonce of the sender after the block is executed
+	nonceAfter := func(height int64) (uint64, error) {
+		n, err := a.backend.GetTransactionCount(sender, rpctypes.BlockNumber(height))
+		if err != nil {
+			return 0, err
+		}
+		return uint64(*n), nil
+	}
+
+	n, err := nonceAfter(int64(latest))
+	if err != nil {
+		return nil, err
+	}
+	if n <= nonce {
+		return nil, nil
+	}
+
+	// find the first block after which the nonce of the sender is greater than the nonce
+	var searchErr error
+	height := sort.Search(int(latest), func(i int) bool {
+		if searchErr != nil {
+			return true
+		}
+		n, err := nonceAfter(int64(i) + 1)
+		if err != nil {
+			searchErr = err
+			return true
+		}
+		return n > nonce
+	}) + 1
+	if searchErr != nil {
+		return nil, searchErr
+	}
+
+	block, err := a.backend.GetBlockByNumber(rpctypes.BlockNumber(height), true)
+	if err != nil || block == nil {
+		return nil, err
+	}
+	txs, _ := block["transactions"].([]interface{})
+	for _, tx := range txs {
+		rpcTx, ok := tx.(*rpctypes.RPCTransaction)
+		if ok && rpcTx.From == sender && uint64(rpcTx.Nonce) == nonce {
+			return &rpcTx.Hash, nil
+		}
+	}
+	// the nonce was consumed by a cosmos transaction
+	return nil, nil
+}
+
+// GetInternalOperations returns the internal value transfers, contract creations and
+// self destructs of the transaction.
+func (a *API) GetInternalOperations(hash common.Hash) ([]*evmtracers.InternalOperation, error) {
+	a.logger.Debug("ots_getInternalOperations", "hash", hash)
+	var operations []*evmtracers.InternalOperation
+	if err := a.traceTransaction(hash, evmtracers.OtsInternalOperationsTracerName, &operations); err != nil {
+		return nil, err
+	}
+	return operations, nil
+}
+
+// TraceTransaction returns the call frames of the transaction in the order they are
+// entered.
+func (a *API) TraceTransaction(hash common.Hash) ([]*evmtracers.TraceEntry, error) {
+	a.logger.Debug("ots_traceTransaction", "hash", hash)
+	var entries []*evmtracers.TraceEntry
+	if err := a.traceTransaction(hash, evmtracers.OtsTraceTracerName, &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// GetTransactionError returns the revert data of the transaction, it's empty if the
+// transaction didn't fail.
+func (a *API) GetTransactionError(hash common.Hash) (hexutil.Bytes, error) {
+	a.logger.Debug("ots_getTransactionError", "hash", hash)
+	res, err := a.backend.GetTxByEthHash(hash)
+	if err != nil {
+		return nil, err
+	}
+	if !res.Failed {
+		return hexutil.Bytes{}, nil
+	}
+
+	entries, err := a.TraceTransaction(hash)
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return hexutil.Bytes{}, nil
+	}
+	return entries[0].Output, nil
+}
+
+// GetContractCreator returns the creator of the contract and the transaction creating
+// it, it's nil if the address isn't a contract. The creation transaction is the first
+// transaction involving the contract in the address tx lists of the indexer, which holds
+// the contracts created by a factory if the internal txs are indexed. Otherwise the
+// creation block is found by a binary search of the contract code over the heights.
+func (a *API) GetContractCreator(address common.Address) (*ContractCreator, error) {
+	a.logger.Debug("ots_getContractCreator", "address", address)
+	latest, err := a.backend.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+	code, err := a.codeAt(address, int64(latest))
+	if err != nil {
+		return nil, err
+	}
+	if len(code) == 0 {
+		return nil, nil
+	}
+
+	// the contract is created by one of the txs of its first block
+	hashes, _, err := a.backend.GetTxsByAddress(address, 0, false, 1)
+	if err != nil {
+		return nil, err
+	}
+	txs := make([]*rpctypes.RPCTransaction, 0, len(hashes))
+	for _, hash := range hashes {
+		tx, err := a.backend.GetTransactionByHash(hash)
+		if err != nil {
+			return nil, err
+		}
+		txs = append(txs, tx)
+	}
+	creator, err := a.findCreator(address, txs)
+	if err != nil || creator != nil {
+		return creator, err
+	}
+
+	// find the first block after which the address holds code
+	var searchErr error
+	height := sort.Search(int(latest), func(i int) bool {
+		if searchErr != nil {
+			return true
+		}
+		code, err := a.codeAt(address, int64(i)+1)
+		if err != nil {
+			searchErr = err
+			return true
+		}
+		return len(code) > 0
+	}) + 1
+	if searchErr != nil {
+		return nil, searchErr
+	}
+
+	block, err := a.backend.GetBlockByNumber(rpctypes.BlockNumber(height), true)
+	if err != nil {
+		return nil, err
+	}
+	txs = txs[:0]
+	blockTxs, _ := block["transactions"].([]interface{})
+	for _, tx := range blockTxs {
+		if rpcTx, ok := tx.(*rpctypes.RPCTransaction); ok {
+			txs = append(txs, rpcTx)
+		}
+	}
+	creator, err = a.findCreator(address, txs)
+	if err != nil || creator != nil {
+		return creator, err
+	}
+	// the code was set by a cosmos transaction, eg. a precompile registration
+	return nil, fmt.Errorf("creator of contract %s not found in block %d", address.Hex(), height)
+}
+
+// findCreator returns the creator of the contract if one of the transactions creates it,
+// either directly or through an internal contract creation.
+func (a *API) findCreator(address common.Address, txs []*rpctypes.RPCTransaction) (*ContractCreator, error) {
+	for _, tx := range txs {
+		if tx.To == nil {
+			if crypto.CreateAddress(tx.From, uint64(tx.Nonce)) == address {
+				return &ContractCreator{Tx: tx.Hash, Creator: tx.From}, nil
+			}
+			continue
+		}
+
+		operations, err := a.GetInternalOperations(tx.Hash)
+		if err != nil {
+			return nil, err
+		}
+		for _, op := range operations {
+			if (op.Type == evmtracers.OperationCreate || op.Type == evmtracers.OperationCreate2) && op.To == address {
+				return &ContractCreator{Tx: tx.Hash, Creator: op.From}, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// codeAt returns the code of the address after the block at height is executed.
+func (a *API) codeAt(address common.Address, height int64) (hexutil.Bytes, error) {
+	blockNr := rpctypes.BlockNumber(height)
+	return a.backend.GetCode(address, rpctypes.BlockNumberOrHash{BlockNumber: &blockNr})
+}
+
+// SearchTransactionsBefore returns the transactions involving the address in the blocks
+// before the block number, the latest blocks if the block number is zero. The
+// transactions are ordered from the newest, and the last block is returned in full
+// even if it exceeds the page size.
+func (a *API) SearchTransactionsBefore(address common.Address, blockNum uint64, pageSize uint16) (*TransactionsWithReceipts, error) {
+	a.logger.Debug("ots_searchTransactionsBefore", "address", address, "block", blockNum, "pageSize", pageSize)
+	if pageSize == 0 {
+		return nil, errors.New("page size must be positive")
+	}
+
+	height := int64(blockNum)
+	if blockNum == 0 {
+		latest, err := a.backend.BlockNumber()
+		if err != nil {
+			return nil, err
+		}
+		height = int64(latest) + 1
+	}
+
+	hashes, more, err := a.backend.GetTxsByAddress(address, height, true, int(pageSize))
+	if err != nil {
+		return nil, err
+	}
+	res, err := a.txsWithReceipts(hashes)
+	if err != nil {
+		return nil, err
+	}
+	res.FirstPage = blockNum == 0
+	res.LastPage = !more
+	return res, nil
+}
+
+// SearchTransactionsAfter returns the transactions involving the address in the blocks
+// after the block number, the first blocks if the block number is zero. The
+// transactions are ordered from the newest, and the last block is returned in full
+// even if it exceeds the page size.
+func (a *API) SearchTransactionsAfter(address common.Address, blockNum uint64, pageSize uint16) (*TransactionsWithReceipts, error) {
+	a.logger.Debug("ots_searchTransactionsAfter", "address", address, "block", blockNum, "pageSize", pageSize)
+	if pageSize == 0 {
+		return nil, errors.New("page size must be positive")
+	}
+
+	hashes, more, err := a.backend.GetTxsByAddress(address, int64(blockNum), false, int(pageSize))
+	if err != nil {
+		return nil, err
+	}
+	// reverse the order to return the newest txs first
+	for i, j := 0, len(hashes)-1; i < j; i, j = i+1, j-1 {
+		hashes[i], hashes[j] = hashes[j], hashes[i]
+	}
+
+	res, err := a.txsWithReceipts(hashes)
+	if err != nil {
+		return nil, err
+	}
+	res.FirstPage = !more
+	res.LastPage = blockNum == 0
+	return res, nil
+}
+
+// blockDetails returns the block without its transactions along with its transaction
+// count, issuance and total fees, the issuance is zero as there are no block rewards in
+// the EVM.
+func (a *API) blockDetails(block map[string]interface{}) (map[string]interface{}, error) {
+	if block == nil {
+		return nil, nil
+	}
+	receipts, err := a.blockReceipts(block)
+	if err != nil {
+		return nil, err
+	}
+	txs, ok := block["transactions"].([]interface{})
+	if !ok || len(txs) != len(receipts) {
+		return nil, fmt.Errorf("invalid transactions of block %v", block["number"])
+	}
+
+	totalFees := new(big.Int)
+	for i, tx := range txs {
+		rpcTx, ok := tx.(*rpctypes.RPCTransaction)
+		if !ok || rpcTx.GasPrice == nil {
+			continue
+		}
+		gasUsed, _ := receipts[i]["gasUsed"].(hexutil.Uint64)
+		fee := new(big.Int).Mul(rpcTx.GasPrice.ToInt(), new(big.Int).SetUint64(uint64(gasUsed)))
+		totalFees.Add(totalFees, fee)
+	}
+
+	delete(block, "transactions")
+	block["transactionCount"] = hexutil.Uint64(len(txs))
+	block["logsBloom"] = nil
+	return map[string]interface{}{
+		"block": block,
+		"issuance": map[string]interface{}{
+			"blockReward": (*hexutil.Big)(new(big.Int)),
+			"uncleReward": (*hexutil.Big)(new(big.Int)),
+			"issuance":    (*hexutil.Big)(new(big.Int)),
+		},
+		"totalFees": (*hexutil.Big)(totalFees),
+	}, nil
+}
+
+// blockReceipts returns the receipts of the transactions of the block
+func (a *API) blockReceipts(block map[string]interface{}) ([]map[string]interface{}, error) {
+	number, ok := block["number"].(hexutil.Uint64)
+	if !ok {
+		return nil, errors.New("invalid block number")
+	}
+	blockNr := rpctypes.BlockNumber(number)
+	receipts, err := a.backend.GetBlockReceipts(rpctypes.BlockNumberOrHash{BlockNumber: &blockNr})
+	if err != nil {
+		return nil, err
+	}
+	if receipts == nil {
+		return nil, fmt.Errorf("receipts of block %d not found", number)
+	}
+	return receipts, nil
+}
+
+// txsWithReceipts returns the transactions and the receipts of the hashes, the receipts
+// contain the timestamp of their block.
+func (a *API) txsWithReceipts(hashes []common.Hash) (*TransactionsWithReceipts, error) {
+	res := &TransactionsWithReceipts{
+		Txs:      make([]*rpctypes.RPCTransaction, 0, len(hashes)),
+		Receipts: make([]map[string]interface{}, 0, len(hashes)),
+	}
+
+	timestamps := make(map[int64]hexutil.Uint64)
+	for _, hash := range hashes {
+		tx, err := a.backend.GetTransactionByHash(hash)
+		if err != nil {
+			return nil, err
+		}
+		if tx == nil || tx.BlockNumber == nil {
+			return nil, fmt.Errorf("transaction %s not found", hash.Hex())
+		}
+		receipt, err := a.backend.GetTransactionReceipt(hash)
+		if err != nil {
+			return nil, err
+		}
+		if receipt == nil {
+			return nil, fmt.Errorf("receipt of transaction %s not found", hash.Hex())
+		}
+
+		height := tx.BlockNumber.ToInt().Int64()
+		timestamp, ok := timestamps[height]
+		if !ok {
+			resBlock, err := a.backend.TendermintBlockByNumber(rpctypes.BlockNumber(height))
+			if err != nil {
+				return nil, err
+			}
+			if resBlock == nil || resBlock.Block == nil {
+				return nil, fmt.Errorf("block not found for height %d", height)
+			}
+			timestamp = hexutil.Uint64(resBlock.Block.Time.Unix())
+			timestamps[height] = timestamp
+		}
+		receipt["timestamp"] = timestamp
+
+		res.Txs = append(res.Txs, tx)
+		res.Receipts = append(res.Receipts, receipt)
+	}
+	return res, nil
+}
+
+// traceTransaction re-executes the transaction with the native tracer and decodes the
+// result into the value pointed by res.
+func (a *API) traceTransaction(hash common.Hash, tracer string, res interface{}) error {
+	result, err := a.backend.TraceTransaction(hash, &evmtypes.TraceConfig{Tracer: tracer})
+	if err != nil {
+		return err
+	}
+
+	bz, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(bz, res); err != nil {
+		return fmt.Errorf("failed to decode %s result: %w", tracer, err)
+	}
+	return nil
+}
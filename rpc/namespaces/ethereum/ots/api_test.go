@@ -0,0 +1,266 @@
+package ots
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cometbft/cometbft/libs/log"
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/rpc/backend"
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+	evmtracers "github.com/evmos/ethermint/x/evm/tracers"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+var (
+	alice    = common.BigToAddress(big.NewInt(1))
+	bob      = common.BigToAddress(big.NewInt(2))
+	factory  = crypto.CreateAddress(alice, 0)
+	child    = crypto.CreateAddress(factory, 1)
+	registry = common.BigToAddress(big.NewInt(3))
+)
+
+// fakeTx is a transaction of the fake chain, created is the contract created by the
+// transaction, directly or internally.
+type fakeTx struct {
+	height  int64
+	from    common.Address
+	nonce   uint64
+	to      *common.Address
+	created common.Address
+}
+
+func (tx fakeTx) hash() common.Hash {
+	return common.BigToHash(big.NewInt(tx.height*100 + int64(tx.nonce)))
+}
+
+func (tx fakeTx) rpcTransaction() *rpctypes.RPCTransaction {
+	return &rpctypes.RPCTransaction{
+		BlockNumber: (*hexutil.Big)(big.NewInt(tx.height)),
+		From:        tx.from,
+		Hash:        tx.hash(),
+		Nonce:       hexutil.Uint64(tx.nonce),
+		To:          tx.to,
+	}
+}
+
+// fakeBackend serves a chain of 20 blocks with alice deploying the factory at block 5
+// and calling it at block 10 to create the child contract, the registry code is set at
+// block 12 without any transaction. The address tx lists hold the participants of the
+// transactions, and the created contracts if the internal txs are indexed.
+type fakeBackend struct {
+	backend.EVMBackend
+	txs             []fakeTx
+	indexInternal   bool
+	registryCreated int64
+}
+
+func newFakeBackend(indexInternal bool) *fakeBackend {
+	return &fakeBackend{
+		txs: []fakeTx{
+			{height: 5, from: alice, nonce: 0, created: factory},
+			{height: 7, from: bob, nonce: 0, to: &alice},
+			{height: 10, from: alice, nonce: 1, to: &factory, created: child},
+			{height: 15, from: bob, nonce: 1, to: &alice},
+		},
+		indexInternal:   indexInternal,
+		registryCreated: 12,
+	}
+}
+
+func (b *fakeBackend) BlockNumber() (hexutil.Uint64, error) {
+	return 20, nil
+}
+
+func (b *fakeBackend) GetCode(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error) {
+	height := blockNrOrHash.BlockNumber.Int64()
+	if address == registry && height >= b.registryCreated {
+		return hexutil.Bytes{0x1}, nil
+	}
+	for _, tx := range b.txs {
+		if tx.created == address && height >= tx.height {
+			return hexutil.Bytes{0x1}, nil
+		}
+	}
+	return hexutil.Bytes{}, nil
+}
+
+func (b *fakeBackend) involves(tx fakeTx, address common.Address) bool {
+	if tx.from == address || (tx.to != nil && *tx.to == address) {
+		return true
+	}
+	return tx.created == address && (tx.to == nil || b.indexInternal)
+}
+
+func (b *fakeBackend) GetTxsByAddress(address common.Address, height int64, reverse bool, pageSize int) ([]common.Hash, bool, error) {
+	hashes := []common.Hash{}
+	more := false
+	for i := range b.txs {
+		tx := b.txs[i]
+		if reverse {
+			tx = b.txs[len(b.txs)-1-i]
+		}
+		if !b.involves(tx, address) || (reverse && tx.height >= height) || (!reverse && tx.height <= height) {
+			continue
+		}
+		if len(hashes) == pageSize {
+			more = true
+			break
+		}
+		hashes = append(hashes, tx.hash())
+	}
+	return hashes, more, nil
+}
+
+func (b *fakeBackend) GetTransactionByHash(hash common.Hash) (*rpctypes.RPCTransaction, error) {
+	for _, tx := range b.txs {
+		if tx.hash() == hash {
+			return tx.rpcTransaction(), nil
+		}
+	}
+	return nil, nil
+}
+
+func (b *fakeBackend) GetTransactionReceipt(hash common.Hash) (map[string]interface{}, error) {
+	return map[string]interface{}{"transactionHash": hash}, nil
+}
+
+func (b *fakeBackend) TendermintBlockByNumber(blockNr rpctypes.BlockNumber) (*tmrpctypes.ResultBlock, error) {
+	return &tmrpctypes.ResultBlock{Block: &tmtypes.Block{Header: tmtypes.Header{Height: blockNr.Int64()}}}, nil
+}
+
+func (b *fakeBackend) GetBlockByNumber(blockNr rpctypes.BlockNumber, _ bool) (map[string]interface{}, error) {
+	txs := []interface{}{}
+	for _, tx := range b.txs {
+		if tx.height == blockNr.Int64() {
+			txs = append(txs, tx.rpcTransaction())
+		}
+	}
+	return map[string]interface{}{
+		"number":       hexutil.Uint64(blockNr),
+		"transactions": txs,
+	}, nil
+}
+
+func (b *fakeBackend) TraceTransaction(hash common.Hash, _ *evmtypes.TraceConfig) (interface{}, error) {
+	operations := []*evmtracers.InternalOperation{}
+	for _, tx := range b.txs {
+		if tx.hash() == hash && tx.to != nil && tx.created != (common.Address{}) {
+			operations = append(operations, &evmtracers.InternalOperation{
+				Type:  evmtracers.OperationCreate,
+				From:  *tx.to,
+				To:    tx.created,
+				Value: (*hexutil.Big)(new(big.Int)),
+			})
+		}
+	}
+	return operations, nil
+}
+
+func TestGetContractCreator(t *testing.T) {
+	testCases := []struct {
+		name          string
+		address       common.Address
+		indexInternal bool
+		expCreator    *ContractCreator
+		expErrMsg     string
+	}{
+		{
+			"contract created by a transaction",
+			factory, false,
+			&ContractCreator{Tx: newFakeBackend(false).txs[0].hash(), Creator: alice}, "",
+		},
+		{
+			"contract created by a factory with the internal txs indexed",
+			child, true,
+			&ContractCreator{Tx: newFakeBackend(false).txs[2].hash(), Creator: factory}, "",
+		},
+		{
+			"contract created by a factory found by the code search",
+			child, false,
+			&ContractCreator{Tx: newFakeBackend(false).txs[2].hash(), Creator: factory}, "",
+		},
+		{
+			"not a contract",
+			bob, false,
+			nil, "",
+		},
+		{
+			"fail - contract code set without a transaction",
+			registry, false,
+			nil, "not found in block 12",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			api := &API{
+				logger:  log.NewNopLogger(),
+				backend: newFakeBackend(tc.indexInternal),
+			}
+
+			creator, err := api.GetContractCreator(tc.address)
+			if tc.expErrMsg != "" {
+				require.ErrorContains(t, err, tc.expErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expCreator, creator)
+		})
+	}
+}
+
+func TestSearchTransactions(t *testing.T) {
+	testCases := []struct {
+		name         string
+		before       bool
+		blockNum     uint64
+		pageSize     uint16
+		expBlocks    []int64
+		expFirstPage bool
+		expLastPage  bool
+		expErrMsg    string
+	}{
+		{"before the latest block", true, 0, 2, []int64{15, 10}, true, false, ""},
+		{"before a block", true, 10, 2, []int64{7, 5}, false, true, ""},
+		{"after the first block", false, 0, 2, []int64{7, 5}, false, true, ""},
+		{"after a block", false, 5, 2, []int64{10, 7}, false, false, ""},
+		{"after a block in one page", false, 5, 3, []int64{15, 10, 7}, true, false, ""},
+		{"fail - zero page size", true, 0, 0, nil, false, false, "page size must be positive"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			api := &API{
+				logger:  log.NewNopLogger(),
+				backend: newFakeBackend(false),
+			}
+
+			search := api.SearchTransactionsAfter
+			if tc.before {
+				search = api.SearchTransactionsBefore
+			}
+			res, err := search(alice, tc.blockNum, tc.pageSize)
+			if tc.expErrMsg != "" {
+				require.ErrorContains(t, err, tc.expErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			blocks := []int64{}
+			for i, tx := range res.Txs {
+				blocks = append(blocks, tx.BlockNumber.ToInt().Int64())
+				require.Equal(t, tx.Hash, res.Receipts[i]["transactionHash"])
+				require.Contains(t, res.Receipts[i], "timestamp")
+			}
+			require.Equal(t, tc.expBlocks, blocks)
+			require.Equal(t, tc.expFirstPage, res.FirstPage)
+			require.Equal(t, tc.expLastPage, res.LastPage)
+		})
+	}
+}
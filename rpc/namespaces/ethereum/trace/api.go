@@ -0,0 +1,239 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package trace
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/ethermint/rpc/backend"
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+	evmtracers "github.com/evmos/ethermint/x/evm/tracers"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// API is the collection of Parity style tracing APIs, the transactions are re-executed
+// with the native flat call tracer.
+type API struct {
+	ctx     *server.Context
+	logger  log.Logger
+	backend backend.EVMBackend
+}
+
+// NewAPI creates a new API definition for the Parity style tracing methods.
+func NewAPI(
+	ctx *server.Context,
+	backend backend.EVMBackend,
+) *API {
+	return &API{
+		ctx:     ctx,
+		logger:  ctx.Logger.With("module", "trace"),
+		backend: backend,
+	}
+}
+
+// Block returns the flat call traces of all the transactions of the block.
+func (a *API) Block(blockNr rpctypes.BlockNumber) ([]*evmtracers.FlatCallFrame, error) {
+	a.logger.Debug("trace_block", "number", blockNr)
+	return a.blockTraces(blockNr)
+}
+
+// Transaction returns the flat call traces of the transaction.
+func (a *API) Transaction(hash common.Hash) ([]*evmtracers.FlatCallFrame, error) {
+	a.logger.Debug("trace_transaction", "hash", hash)
+	tx, err := a.backend.GetTxByEthHash(hash)
+	if err != nil {
+		a.logger.Debug("tx not found", "hash", hash)
+		return nil, err
+	}
+
+	res, err := a.backend.TraceTransaction(hash, flatCallTraceConfig())
+	if err != nil {
+		return nil, err
+	}
+	return decodeFlatCallFrames(res, tx.Height)
+}
+
+// Filter returns the flat call traces of the transactions within the block range
+// matching the from and to addresses, the range is capped by the block range cap of
+// the JSON-RPC server.
+func (a *API) Filter(args rpctypes.TraceFilterArgs) ([]*evmtracers.FlatCallFrame, error) {
+	a.logger.Debug("trace_filter", "args", args)
+	latest, err := a.backend.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := resolveBlockNumber(args.FromBlock, int64(latest))
+	if err != nil {
+		return nil, err
+	}
+	to, err := resolveBlockNumber(args.ToBlock, int64(latest))
+	if err != nil {
+		return nil, err
+	}
+	if from > to {
+		return nil, fmt.Errorf("invalid block range, from %d is greater than to %d", from, to)
+	}
+	if blockLimit := int64(a.backend.RPCBlockRangeCap()); to-from > blockLimit {
+		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
+	}
+
+	var after, count uint64
+	if args.After != nil {
+		after = *args.After
+	}
+	if args.Count != nil {
+		count = *args.Count
+	}
+
+	traces := []*evmtracers.FlatCallFrame{}
+	for height := from; height <= to; height++ {
+		blockTraces, err := a.blockTraces(rpctypes.BlockNumber(height))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, trace := range blockTraces {
+			if !matchAddresses(trace, args.FromAddress, args.ToAddress) {
+				continue
+			}
+			if after > 0 {
+				after--
+				continue
+			}
+			traces = append(traces, trace)
+			if args.Count != nil && uint64(len(traces)) == count {
+				return traces, nil
+			}
+		}
+	}
+	return traces, nil
+}
+
+// blockTraces re-executes the transactions of the block and returns their flat call
+// traces, it fails if any of the transactions can't be traced.
+func (a *API) blockTraces(blockNr rpctypes.BlockNumber) ([]*evmtracers.FlatCallFrame, error) {
+	resBlock, err := a.backend.TendermintBlockByNumber(blockNr)
+	if err != nil {
+		a.logger.Debug("get block failed", "height", blockNr, "error", err.Error())
+		return nil, err
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, fmt.Errorf("block not found for height %d", blockNr)
+	}
+	height := resBlock.Block.Height
+	if height == 0 {
+		return nil, errors.New("genesis is not traceable")
+	}
+
+	results, err := a.backend.TraceBlock(rpctypes.BlockNumber(height), flatCallTraceConfig(), resBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	traces := []*evmtracers.FlatCallFrame{}
+	for i, result := range results {
+		if result.Error != "" {
+			return nil, fmt.Errorf("failed to trace transaction %d of block %d: %s", i, height, result.Error)
+		}
+
+		frames, err := decodeFlatCallFrames(result.Result, height)
+		if err != nil {
+			return nil, err
+		}
+		traces = append(traces, frames...)
+	}
+	return traces, nil
+}
+
+// flatCallTraceConfig returns the trace config selecting the native flat call tracer
+func flatCallTraceConfig() *evmtypes.TraceConfig {
+	return &evmtypes.TraceConfig{Tracer: evmtracers.FlatCallTracerName}
+}
+
+// decodeFlatCallFrames decodes the json result of the flat call tracer and sets the
+// block number of the frames.
+func decodeFlatCallFrames(result interface{}, height int64) ([]*evmtracers.FlatCallFrame, error) {
+	bz, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+
+	var frames []*evmtracers.FlatCallFrame
+	if err := json.Unmarshal(bz, &frames); err != nil {
+		return nil, fmt.Errorf("failed to decode flat call traces: %w", err)
+	}
+	for _, frame := range frames {
+		frame.BlockNumber = uint64(height)
+	}
+	return frames, nil
+}
+
+// resolveBlockNumber returns the height of the block number, the special block
+// numbers and a missing one resolve to the latest block, and the genesis to the
+// first block.
+func resolveBlockNumber(blockNr *rpctypes.BlockNumber, latest int64) (int64, error) {
+	if blockNr == nil || *blockNr < 0 {
+		return latest, nil
+	}
+	if *blockNr == 0 {
+		return 1, nil
+	}
+	if blockNr.Int64() > latest {
+		return 0, fmt.Errorf("block %d is greater than the latest block %d", blockNr.Int64(), latest)
+	}
+	return blockNr.Int64(), nil
+}
+
+// matchAddresses returns true if the sender and the recipient of the trace are within
+// the from and to addresses, empty addresses match any trace.
+func matchAddresses(trace *evmtracers.FlatCallFrame, fromAddresses, toAddresses []common.Address) bool {
+	var from, to *common.Address
+	switch trace.Type {
+	case evmtracers.TraceTypeSuicide:
+		from, to = trace.Action.Address, trace.Action.RefundAddress
+	case evmtracers.TraceTypeCreate:
+		from = trace.Action.From
+		if trace.Result != nil {
+			to = trace.Result.Address
+		}
+	default:
+		from, to = trace.Action.From, trace.Action.To
+	}
+	return includes(fromAddresses, from) && includes(toAddresses, to)
+}
+
+// includes returns true if the addresses are empty or contain the address
+func includes(addresses []common.Address, address *common.Address) bool {
+	if len(addresses) == 0 {
+		return true
+	}
+	if address == nil {
+		return false
+	}
+	for _, addr := range addresses {
+		if addr == *address {
+			return true
+		}
+	}
+	return false
+}
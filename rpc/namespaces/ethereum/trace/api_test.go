@@ -0,0 +1,236 @@
+package trace
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cometbft/cometbft/libs/log"
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/rpc/backend"
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+	evmtracers "github.com/evmos/ethermint/x/evm/tracers"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+var (
+	alice    = common.BigToAddress(big.NewInt(1))
+	bob      = common.BigToAddress(big.NewInt(2))
+	contract = common.BigToAddress(big.NewInt(3))
+)
+
+// fakeBackend serves blocks up to the head with two transactions each, a call from
+// alice and a call from bob to the contract, the transactions of the failing heights
+// can't be traced.
+type fakeBackend struct {
+	backend.EVMBackend
+	head    int64
+	failing map[int64]bool
+}
+
+func (b *fakeBackend) BlockNumber() (hexutil.Uint64, error) {
+	return hexutil.Uint64(b.head), nil
+}
+
+func (b *fakeBackend) RPCBlockRangeCap() int32 {
+	return 10
+}
+
+func (b *fakeBackend) TendermintBlockByNumber(blockNr rpctypes.BlockNumber) (*tmrpctypes.ResultBlock, error) {
+	return &tmrpctypes.ResultBlock{Block: &tmtypes.Block{Header: tmtypes.Header{Height: blockNr.Int64()}}}, nil
+}
+
+func (b *fakeBackend) TraceBlock(height rpctypes.BlockNumber, _ *evmtypes.TraceConfig, _ *tmrpctypes.ResultBlock) ([]*evmtypes.TxTraceResult, error) {
+	results := []*evmtypes.TxTraceResult{}
+	for i, from := range []common.Address{alice, bob} {
+		if b.failing[height.Int64()] {
+			results = append(results, &evmtypes.TxTraceResult{Error: "execution reverted"})
+			continue
+		}
+		from := from
+		results = append(results, &evmtypes.TxTraceResult{Result: []*evmtracers.FlatCallFrame{{
+			Action:              evmtracers.FlatCallAction{From: &from, To: &contract},
+			TransactionPosition: uint64(i),
+			Type:                evmtracers.TraceTypeCall,
+		}}})
+	}
+	return results, nil
+}
+
+func blockNumber(n int64) *rpctypes.BlockNumber {
+	blockNr := rpctypes.BlockNumber(n)
+	return &blockNr
+}
+
+func uint64Ptr(n uint64) *uint64 {
+	return &n
+}
+
+func TestFilter(t *testing.T) {
+	testCases := []struct {
+		name      string
+		args      rpctypes.TraceFilterArgs
+		failing   map[int64]bool
+		expBlocks []uint64
+		expErrMsg string
+	}{
+		{
+			"block range",
+			rpctypes.TraceFilterArgs{FromBlock: blockNumber(2), ToBlock: blockNumber(3)},
+			nil, []uint64{2, 2, 3, 3}, "",
+		},
+		{
+			"latest block by default",
+			rpctypes.TraceFilterArgs{},
+			nil, []uint64{20, 20}, "",
+		},
+		{
+			"from address",
+			rpctypes.TraceFilterArgs{FromBlock: blockNumber(2), ToBlock: blockNumber(4), FromAddress: []common.Address{bob}},
+			nil, []uint64{2, 3, 4}, "",
+		},
+		{
+			"to address",
+			rpctypes.TraceFilterArgs{FromBlock: blockNumber(2), ToBlock: blockNumber(3), ToAddress: []common.Address{alice}},
+			nil, []uint64{}, "",
+		},
+		{
+			"after skips the first traces",
+			rpctypes.TraceFilterArgs{FromBlock: blockNumber(2), ToBlock: blockNumber(4), After: uint64Ptr(3)},
+			nil, []uint64{3, 4, 4}, "",
+		},
+		{
+			"count stops the traces",
+			rpctypes.TraceFilterArgs{FromBlock: blockNumber(2), ToBlock: blockNumber(4), Count: uint64Ptr(3)},
+			nil, []uint64{2, 2, 3}, "",
+		},
+		{
+			"after and count of the matching traces",
+			rpctypes.TraceFilterArgs{FromBlock: blockNumber(1), ToBlock: blockNumber(10), FromAddress: []common.Address{alice}, After: uint64Ptr(2), Count: uint64Ptr(2)},
+			nil, []uint64{3, 4}, "",
+		},
+		{
+			"count stops before the failing block",
+			rpctypes.TraceFilterArgs{FromBlock: blockNumber(2), ToBlock: blockNumber(4), Count: uint64Ptr(2)},
+			map[int64]bool{3: true}, []uint64{2, 2}, "",
+		},
+		{
+			"fail - transaction can't be traced",
+			rpctypes.TraceFilterArgs{FromBlock: blockNumber(2), ToBlock: blockNumber(4)},
+			map[int64]bool{3: true}, nil, "failed to trace transaction 0 of block 3",
+		},
+		{
+			"fail - inverted block range",
+			rpctypes.TraceFilterArgs{FromBlock: blockNumber(4), ToBlock: blockNumber(2)},
+			nil, nil, "invalid block range",
+		},
+		{
+			"fail - block range greater than the cap",
+			rpctypes.TraceFilterArgs{FromBlock: blockNumber(1), ToBlock: blockNumber(12)},
+			nil, nil, "maximum [from, to] blocks distance: 10",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			api := &API{
+				logger:  log.NewNopLogger(),
+				backend: &fakeBackend{head: 20, failing: tc.failing},
+			}
+
+			traces, err := api.Filter(tc.args)
+			if tc.expErrMsg != "" {
+				require.ErrorContains(t, err, tc.expErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			blocks := []uint64{}
+			for _, trace := range traces {
+				blocks = append(blocks, trace.BlockNumber)
+			}
+			require.Equal(t, tc.expBlocks, blocks)
+		})
+	}
+}
+
+func TestResolveBlockNumber(t *testing.T) {
+	testCases := []struct {
+		name      string
+		blockNr   *rpctypes.BlockNumber
+		expHeight int64
+		expErrMsg string
+	}{
+		{"missing block number", nil, 20, ""},
+		{"latest", blockNumber(int64(rpctypes.EthLatestBlockNumber)), 20, ""},
+		{"pending", blockNumber(int64(rpctypes.EthPendingBlockNumber)), 20, ""},
+		{"genesis", blockNumber(0), 1, ""},
+		{"height", blockNumber(5), 5, ""},
+		{"latest height", blockNumber(20), 20, ""},
+		{"fail - future height", blockNumber(21), 0, "block 21 is greater than the latest block 20"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			height, err := resolveBlockNumber(tc.blockNr, 20)
+			if tc.expErrMsg != "" {
+				require.ErrorContains(t, err, tc.expErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expHeight, height)
+		})
+	}
+}
+
+func TestMatchAddresses(t *testing.T) {
+	call := &evmtracers.FlatCallFrame{
+		Type:   evmtracers.TraceTypeCall,
+		Action: evmtracers.FlatCallAction{From: &alice, To: &contract},
+	}
+	create := &evmtracers.FlatCallFrame{
+		Type:   evmtracers.TraceTypeCreate,
+		Action: evmtracers.FlatCallAction{From: &alice},
+		Result: &evmtracers.FlatCallResult{Address: &contract},
+	}
+	failedCreate := &evmtracers.FlatCallFrame{
+		Type:   evmtracers.TraceTypeCreate,
+		Action: evmtracers.FlatCallAction{From: &alice},
+		Error:  "out of gas",
+	}
+	suicide := &evmtracers.FlatCallFrame{
+		Type:   evmtracers.TraceTypeSuicide,
+		Action: evmtracers.FlatCallAction{Address: &contract, RefundAddress: &bob},
+	}
+
+	testCases := []struct {
+		name     string
+		trace    *evmtracers.FlatCallFrame
+		from     []common.Address
+		to       []common.Address
+		expMatch bool
+	}{
+		{"no addresses", call, nil, nil, true},
+		{"call from", call, []common.Address{bob, alice}, nil, true},
+		{"call from doesn't match", call, []common.Address{bob}, nil, false},
+		{"call to", call, nil, []common.Address{contract}, true},
+		{"call to doesn't match", call, nil, []common.Address{bob}, false},
+		{"call from and to", call, []common.Address{alice}, []common.Address{contract}, true},
+		{"call from matches and to doesn't", call, []common.Address{alice}, []common.Address{bob}, false},
+		{"create to the created contract", create, []common.Address{alice}, []common.Address{contract}, true},
+		{"failed create doesn't match to", failedCreate, nil, []common.Address{contract}, false},
+		{"failed create from", failedCreate, []common.Address{alice}, nil, true},
+		{"suicide from the contract", suicide, []common.Address{contract}, nil, true},
+		{"suicide to the refund address", suicide, nil, []common.Address{bob}, true},
+		{"suicide from doesn't match the refund address", suicide, []common.Address{bob}, nil, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expMatch, matchAddresses(tc.trace, tc.from, tc.to))
+		})
+	}
+}
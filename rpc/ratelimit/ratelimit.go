@@ -0,0 +1,241 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package ratelimit
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/evmos/ethermint/rpc/jsonrpc"
+	"github.com/evmos/ethermint/server/config"
+)
+
+const (
+	// ErrCodeLimitExceeded is the JSON-RPC error code of the throttled requests
+	ErrCodeLimitExceeded = -32005
+	// ErrMsgLimitExceeded is the JSON-RPC error message of the throttled requests
+	ErrMsgLimitExceeded = "limit exceeded"
+	// ErrCodeBatchTooHeavy is the JSON-RPC error code of the batch requests weighing more than
+	// the burst, which are never allowed, the same as for the invalid requests.
+	ErrCodeBatchTooHeavy = -32600
+
+	// ForwardedForHeader is the http header carrying the addresses of the client and of the
+	// proxies the request went through, appended by each proxy.
+	ForwardedForHeader = "X-Forwarded-For"
+
+	// BypassHeader is the http header carrying the bypass token of the limiter, it's set by
+	// the websocket server which forwards the already limited requests to the http server.
+	BypassHeader = "X-Rate-Limit-Bypass"
+
+	// pruneInterval is the interval at which the buckets of the idle clients are dropped
+	pruneInterval = time.Minute
+)
+
+// Limiter rate limits the JSON-RPC requests with a token bucket per client IP, every
+// request consumes the weight of its method and every element of a batch is counted.
+type Limiter struct {
+	limit   rate.Limit
+	burst   int
+	weights map[string]int
+	token   string
+	// trustedProxies are the networks of the proxies whose forwarded for header is trusted
+	trustedProxies []*net.IPNet
+
+	mu        sync.Mutex
+	buckets   map[string]*rate.Limiter
+	lastPrune time.Time
+}
+
+// NewLimiter creates a Limiter from the rate limit configuration.
+func NewLimiter(cfg config.RateLimitConfig) *Limiter {
+	weights := make(map[string]int, len(cfg.MethodWeights))
+	for method, weight := range cfg.MethodWeights {
+		weights[strings.ToLower(method)] = weight
+	}
+
+	// the proxies are validated with the configuration
+	trustedProxies := make([]*net.IPNet, 0, len(cfg.TrustedProxies))
+	for _, proxy := range cfg.TrustedProxies {
+		if network, err := config.ParseNetwork(proxy); err == nil {
+			trustedProxies = append(trustedProxies, network)
+		}
+	}
+
+	bz := make([]byte, 32)
+	if _, err := rand.Read(bz); err != nil {
+		panic(err)
+	}
+
+	return &Limiter{
+		limit:          rate.Limit(cfg.RequestsPerSecond),
+		burst:          cfg.Burst,
+		weights:        weights,
+		token:          hex.EncodeToString(bz),
+		trustedProxies: trustedProxies,
+		buckets:        make(map[string]*rate.Limiter),
+		lastPrune:      time.Now(),
+	}
+}
+
+// Weight returns the number of tokens consumed by a call of the method.
+func (l *Limiter) Weight(method string) int {
+	if weight, ok := l.weights[strings.ToLower(method)]; ok {
+		return weight
+	}
+	return 1
+}
+
+// Cost returns the number of tokens consumed by the calls of the methods.
+func (l *Limiter) Cost(methods ...string) int {
+	var cost int
+	for _, method := range methods {
+		cost += l.Weight(method)
+	}
+	return cost
+}
+
+// Allow consumes the weight of the methods from the bucket of the client and returns false
+// without consuming anything if the bucket doesn't hold enough tokens.
+func (l *Limiter) Allow(client string, methods ...string) bool {
+	cost := l.Cost(methods...)
+	now := time.Now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Sub(l.lastPrune) >= pruneInterval {
+		// a full bucket behaves the same as a new one
+		for key, bucket := range l.buckets {
+			if bucket.TokensAt(now) >= float64(l.burst) {
+				delete(l.buckets, key)
+			}
+		}
+		l.lastPrune = now
+	}
+
+	bucket, ok := l.buckets[client]
+	if !ok {
+		bucket = rate.NewLimiter(l.limit, l.burst)
+		l.buckets[client] = bucket
+	}
+	return bucket.AllowN(now, cost)
+}
+
+// BypassToken returns the value of the BypassHeader which exempts a http request from the limiter.
+func (l *Limiter) BypassToken() string {
+	return l.token
+}
+
+// Handler returns a http handler which rejects the requests of the clients exceeding their
+// rate limit and passes the other ones to next.
+func (l *Limiter) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(BypassHeader) == l.token {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		r, body, err := jsonrpc.ReadBody(w, r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+			return
+		}
+
+		if res := l.Reject(l.ClientIP(r), body); res != nil {
+			jsonrpc.WriteJSON(w, res)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// Reject consumes the weight of the requests from the bucket of the client and returns nil,
+// or returns the error response of the requests if they are rejected. A batch weighing more
+// than the burst gets a distinct error as it's never allowed.
+func (l *Limiter) Reject(client string, body *jsonrpc.Body) interface{} {
+	methods := body.Methods()
+	if cost := l.Cost(methods...); cost > l.burst {
+		return body.ErrorResponses(
+			ErrCodeBatchTooHeavy,
+			fmt.Sprintf("batch weight %d exceeds the rate limit burst %d", cost, l.burst),
+		)
+	}
+	if !l.Allow(client, methods...) {
+		return LimitExceededResponse(body)
+	}
+	return nil
+}
+
+// LimitExceededResponse returns the limit exceeded error response of the requests, one per
+// element if it's a batch request.
+func LimitExceededResponse(body *jsonrpc.Body) interface{} {
+	return body.ErrorResponses(ErrCodeLimitExceeded, ErrMsgLimitExceeded)
+}
+
+// ClientIP returns the IP of the client of the http request. If the request is sent by a
+// trusted proxy, the client is the last address of the forwarded for header which isn't a
+// trusted proxy. A nil Limiter trusts no proxy.
+func (l *Limiter) ClientIP(r *http.Request) string {
+	ip := remoteIP(r)
+	if l == nil || !l.trusted(ip) {
+		return ip
+	}
+
+	forwarded := strings.Split(strings.Join(r.Header.Values(ForwardedForHeader), ","), ",")
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		addr := strings.TrimSpace(forwarded[i])
+		if net.ParseIP(addr) == nil {
+			// the addresses before a malformed one can't be trusted
+			break
+		}
+		ip = addr
+		if !l.trusted(addr) {
+			break
+		}
+	}
+	return ip
+}
+
+// trusted returns true if the IP is the one of a trusted proxy.
+func (l *Limiter) trusted(ip string) bool {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+	for _, network := range l.trustedProxies {
+		if network.Contains(parsed) {
+			return true
+		}
+	}
+	return false
+}
+
+// remoteIP returns the IP of the peer of the http request.
+func remoteIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
@@ -0,0 +1,122 @@
+package ratelimit_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/rpc/jsonrpc"
+	"github.com/evmos/ethermint/rpc/ratelimit"
+	"github.com/evmos/ethermint/server/config"
+)
+
+func newLimiter() *ratelimit.Limiter {
+	return ratelimit.NewLimiter(config.RateLimitConfig{
+		Enable:            true,
+		RequestsPerSecond: 0.001,
+		Burst:             10,
+		MethodWeights:     map[string]int{"eth_getlogs": 4},
+	})
+}
+
+func TestLimiterAllow(t *testing.T) {
+	limiter := newLimiter()
+	require.Equal(t, 4, limiter.Weight("eth_getLogs"))
+	require.Equal(t, 1, limiter.Weight("eth_blockNumber"))
+
+	require.True(t, limiter.Allow("1.1.1.1", "eth_getLogs", "eth_getLogs"))
+	// 2 tokens left, a rejected request consumes nothing
+	require.False(t, limiter.Allow("1.1.1.1", "eth_getLogs"))
+	require.True(t, limiter.Allow("1.1.1.1", "eth_blockNumber", "eth_chainId"))
+	require.False(t, limiter.Allow("1.1.1.1", "eth_blockNumber"))
+	// the buckets are per client
+	require.True(t, limiter.Allow("2.2.2.2", "eth_getLogs"))
+}
+
+func TestHandler(t *testing.T) {
+	limiter := newLimiter()
+	var served int
+	handler := limiter.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		served++
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	post := func(body string, bypass bool) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		req.RemoteAddr = "1.1.1.1:1234"
+		if bypass {
+			req.Header.Set(ratelimit.BypassHeader, limiter.BypassToken())
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	// batch of 12 tokens exceeds the burst, every element gets an error which isn't a throttling
+	rec := post(`[{"id":1,"method":"eth_getLogs"},{"id":"a","method":"eth_getLogs"},{"id":3,"method":"eth_getLogs"}]`, false)
+	var batchRes []jsonrpc.ErrorResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &batchRes))
+	require.Len(t, batchRes, 3)
+	require.Equal(t, `"a"`, string(batchRes[1].ID))
+	require.Equal(t, ratelimit.ErrCodeBatchTooHeavy, batchRes[1].Error.Code)
+	require.Equal(t, "batch weight 12 exceeds the rate limit burst 10", batchRes[1].Error.Message)
+	require.Equal(t, 0, served)
+
+	rec = post(`[{"id":1,"method":"eth_getLogs"},{"id":2,"method":"eth_getLogs"}]`, false)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, 1, served)
+
+	rec = post(`{"jsonrpc":"2.0","id":7,"method":"eth_getLogs"}`, false)
+	var res jsonrpc.ErrorResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	require.Equal(t, "7", string(res.ID))
+	require.Equal(t, ratelimit.ErrCodeLimitExceeded, res.Error.Code)
+	require.Equal(t, ratelimit.ErrMsgLimitExceeded, res.Error.Message)
+	require.Equal(t, 1, served)
+
+	// the requests forwarded by the websocket server are not limited again
+	post(`{"jsonrpc":"2.0","id":7,"method":"eth_getLogs"}`, true)
+	require.Equal(t, 2, served)
+}
+
+func TestClientIP(t *testing.T) {
+	limiter := ratelimit.NewLimiter(config.RateLimitConfig{
+		Enable:            true,
+		RequestsPerSecond: 1,
+		Burst:             1,
+		TrustedProxies:    []string{"10.0.0.0/8", "127.0.0.1"},
+	})
+
+	testCases := []struct {
+		name       string
+		limiter    *ratelimit.Limiter
+		remoteAddr string
+		forwarded  []string
+		expIP      string
+	}{
+		{"no proxy", limiter, "1.1.1.1:1234", nil, "1.1.1.1"},
+		{"untrusted proxy", limiter, "1.1.1.1:1234", []string{"2.2.2.2"}, "1.1.1.1"},
+		{"trusted proxy", limiter, "127.0.0.1:1234", []string{"2.2.2.2"}, "2.2.2.2"},
+		{"trusted proxy without header", limiter, "127.0.0.1:1234", nil, "127.0.0.1"},
+		{"chain of trusted proxies", limiter, "127.0.0.1:1234", []string{"3.3.3.3, 2.2.2.2", "10.0.0.2"}, "2.2.2.2"},
+		{"spoofed header", limiter, "10.0.0.1:1234", []string{"10.0.0.3, 2.2.2.2"}, "2.2.2.2"},
+		{"only trusted proxies", limiter, "10.0.0.1:1234", []string{"10.0.0.2"}, "10.0.0.2"},
+		{"malformed address", limiter, "10.0.0.1:1234", []string{"2.2.2.2, unknown"}, "10.0.0.1"},
+		{"nil limiter", nil, "127.0.0.1:1234", []string{"2.2.2.2"}, "127.0.0.1"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			req.RemoteAddr = tc.remoteAddr
+			for _, value := range tc.forwarded {
+				req.Header.Add(ratelimit.ForwardedForHeader, value)
+			}
+			require.Equal(t, tc.expIP, tc.limiter.ClientIP(req))
+		})
+	}
+}
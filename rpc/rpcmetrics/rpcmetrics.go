@@ -0,0 +1,254 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package rpcmetrics
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"time"
+	"unicode"
+
+	"github.com/ethereum/go-ethereum/metrics"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/evmos/ethermint/rpc/jsonrpc"
+)
+
+// The metrics recorded in the go-ethereum metrics registry, exported by the metrics server with
+// the slashes replaced by underscores, eg. jsonrpc_method_eth_call_requests:
+//
+//	jsonrpc/requests                     counter of all the calls
+//	jsonrpc/errors                       counter of all the calls answered with an error
+//	jsonrpc/inflight                     gauge of the calls being served
+//	jsonrpc/method/<method>/requests     counter of the calls of the method
+//	jsonrpc/method/<method>/errors       counter of the calls of the method answered with an error
+//	jsonrpc/method/<method>/inflight     gauge of the calls of the method being served
+//	jsonrpc/method/<method>/duration     histogram of the serving time of the method in microseconds, the
+//	                                     calls of a batch are timed with the whole batch
+//	jsonrpc/errors/<code>                counter of the errors by absolute JSON-RPC error code
+//	jsonrpc/subscriptions/<type>         gauge of the active websocket subscriptions of the type
+const (
+	namespace = "jsonrpc"
+
+	// UnknownMethod is the method name the calls of the methods not served are recorded under,
+	// so that the number of metrics doesn't depend on the requests.
+	UnknownMethod = "unknown"
+)
+
+// subscriptionTypes are the websocket subscription types recorded, see the websocket server
+var subscriptionTypes = map[string]bool{
+	"newHeads":               true,
+	"logs":                   true,
+	"newPendingTransactions": true,
+	"syncing":                true,
+	"traceBlock":             true,
+}
+
+// Metrics records per method metrics of the JSON-RPC calls. A nil Metrics records nothing.
+type Metrics struct {
+	methods map[string]bool
+}
+
+// New creates a Metrics recording the methods of the apis, the other methods are recorded
+// as UnknownMethod. The websocket subscription methods are always recorded.
+func New(apis []rpc.API) *Metrics {
+	methods := map[string]bool{
+		"eth_subscribe":   true,
+		"eth_unsubscribe": true,
+	}
+	for _, api := range apis {
+		typ := reflect.TypeOf(api.Service)
+		for i := 0; i < typ.NumMethod(); i++ {
+			// same naming as the go-ethereum rpc server
+			name := []rune(typ.Method(i).Name)
+			name[0] = unicode.ToLower(name[0])
+			methods[api.Namespace+"_"+string(name)] = true
+		}
+	}
+	return &Metrics{methods: methods}
+}
+
+// Begin records the start of a call of the method and returns the function to call when it's
+// served, with the JSON-RPC error code of the response or 0 if it succeeded.
+func (m *Metrics) Begin(method string) func(code int) {
+	if m == nil {
+		return func(int) {}
+	}
+	if !m.methods[method] {
+		method = UnknownMethod
+	}
+	prefix := fmt.Sprintf("%s/method/%s", namespace, method)
+
+	metrics.GetOrRegisterCounter(namespace+"/requests", nil).Inc(1)
+	metrics.GetOrRegisterCounter(prefix+"/requests", nil).Inc(1)
+	inflight := metrics.GetOrRegisterGauge(namespace+"/inflight", nil)
+	methodInflight := metrics.GetOrRegisterGauge(prefix+"/inflight", nil)
+	inflight.Inc(1)
+	methodInflight.Inc(1)
+
+	start := time.Now()
+	return func(code int) {
+		inflight.Dec(1)
+		methodInflight.Dec(1)
+		metrics.GetOrRegisterHistogramLazy(prefix+"/duration", nil, newSample).Update(time.Since(start).Microseconds())
+
+		if code == 0 {
+			return
+		}
+		if code < 0 {
+			code = -code
+		}
+		metrics.GetOrRegisterCounter(namespace+"/errors", nil).Inc(1)
+		metrics.GetOrRegisterCounter(prefix+"/errors", nil).Inc(1)
+		metrics.GetOrRegisterCounter(fmt.Sprintf("%s/errors/%d", namespace, code), nil).Inc(1)
+	}
+}
+
+// Subscribed records an active websocket subscription of the type and returns the function to
+// call when it's cancelled.
+func (m *Metrics) Subscribed(typ string) func() {
+	if m == nil || !subscriptionTypes[typ] {
+		return func() {}
+	}
+	gauge := metrics.GetOrRegisterGauge(fmt.Sprintf("%s/subscriptions/%s", namespace, typ), nil)
+	gauge.Inc(1)
+	return func() {
+		gauge.Dec(1)
+	}
+}
+
+// newSample is the sample of the duration histograms, same as the go-ethereum rpc server.
+func newSample() metrics.Sample {
+	return metrics.ResettingSample(metrics.NewExpDecaySample(1028, 0.015))
+}
+
+// Handler returns a http handler recording the calls served by next. The request is passed
+// to next as is, the elements of a batch request are all timed with the serving time of the
+// whole batch, and their error codes are read from the response while it's written.
+func (m *Metrics) Handler(next http.Handler) http.Handler {
+	if m == nil {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r, body, err := jsonrpc.ReadBody(w, r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+			return
+		}
+
+		calls := body.Requests
+		dones := make([]func(code int), len(calls))
+		for i, call := range calls {
+			dones[i] = m.Begin(call.Method)
+		}
+
+		rw := &responseWriter{ResponseWriter: w, codes: make(map[string][]int)}
+		next.ServeHTTP(rw, r)
+
+		for i, call := range calls {
+			dones[i](rw.errorCode(call.ID))
+		}
+	})
+}
+
+// Record records the calls of the requests answered at once with the response, like the
+// requests rejected by the websocket server before they are served.
+func (m *Metrics) Record(body *jsonrpc.Body, res interface{}) {
+	if m == nil {
+		return
+	}
+	dones := make([]func(code int), len(body.Requests))
+	for i, call := range body.Requests {
+		dones[i] = m.Begin(call.Method)
+	}
+
+	rw := &responseWriter{codes: make(map[string][]int)}
+	if bz, err := json.Marshal(res); err == nil {
+		rw.record(bz)
+	}
+	for i, call := range body.Requests {
+		dones[i](rw.errorCode(call.ID))
+	}
+}
+
+// responseWriter passes the response through and records the error codes of the JSON-RPC
+// responses by id. The go-ethereum http server writes each response at once, the bytes are
+// only retained until they can be parsed if a response is written in several parts.
+type responseWriter struct {
+	http.ResponseWriter
+	codes   map[string][]int // id -> error codes, in the order of the responses
+	pending []byte
+}
+
+func (w *responseWriter) Write(bz []byte) (int, error) {
+	res := bz
+	if len(w.pending) > 0 {
+		w.pending = append(w.pending, bz...)
+		res = w.pending
+	}
+	if w.record(res) {
+		w.pending = nil
+	} else if len(w.pending) == 0 {
+		w.pending = append([]byte{}, bz...)
+	}
+	return w.ResponseWriter.Write(bz)
+}
+
+// record records the error codes of a single or batch response, it returns false if the
+// response can't be parsed.
+func (w *responseWriter) record(res []byte) bool {
+	var batch []response
+	if jsonrpc.IsBatch(res) {
+		if err := json.Unmarshal(res, &batch); err != nil {
+			return false
+		}
+	} else {
+		var single response
+		if err := json.Unmarshal(res, &single); err != nil {
+			return false
+		}
+		batch = []response{single}
+	}
+	for _, msg := range batch {
+		code := 0
+		if msg.Error != nil {
+			code = msg.Error.Code
+		}
+		w.codes[string(msg.ID)] = append(w.codes[string(msg.ID)], code)
+	}
+	return true
+}
+
+// errorCode returns the error code of the next response to the call with the id, 0 if
+// there's none like for the notifications.
+func (w *responseWriter) errorCode(id json.RawMessage) int {
+	codes := w.codes[string(id)]
+	if len(id) == 0 || len(codes) == 0 {
+		return 0
+	}
+	w.codes[string(id)] = codes[1:]
+	return codes[0]
+}
+
+// response is the part of a JSON-RPC response recorded
+type response struct {
+	ID    json.RawMessage `json:"id"`
+	Error *struct {
+		Code int `json:"code"`
+	} `json:"error"`
+}
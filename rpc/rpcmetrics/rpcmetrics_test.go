@@ -0,0 +1,208 @@
+package rpcmetrics_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/metrics"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/rpc/jsonrpc"
+	"github.com/evmos/ethermint/rpc/methodfilter"
+	"github.com/evmos/ethermint/rpc/ratelimit"
+	"github.com/evmos/ethermint/rpc/rpcmetrics"
+	"github.com/evmos/ethermint/server/config"
+)
+
+type testService struct{}
+
+func (testService) Echo(s string) string {
+	return s
+}
+
+func (testService) Fail() error {
+	return errors.New("failed")
+}
+
+func counter(name string) int64 {
+	if c, ok := metrics.DefaultRegistry.Get(name).(metrics.Counter); ok {
+		return c.Count()
+	}
+	return 0
+}
+
+func histogramCount(name string) int64 {
+	if h, ok := metrics.DefaultRegistry.Get(name).(metrics.Histogram); ok {
+		return h.Count()
+	}
+	return 0
+}
+
+func TestHandler(t *testing.T) {
+	metrics.Enabled = true
+	metrics.DefaultRegistry.UnregisterAll()
+
+	apis := []rpc.API{{Namespace: "test", Service: testService{}}}
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("test", testService{}))
+	served := 0
+	handler := rpcmetrics.New(apis).Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		served++
+		server.ServeHTTP(w, r)
+	}))
+
+	post := func(body string) string {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+		res, err := io.ReadAll(rec.Body)
+		require.NoError(t, err)
+		return string(res)
+	}
+
+	res := post(`{"jsonrpc":"2.0","id":1,"method":"test_echo","params":["hello"]}`)
+	require.JSONEq(t, `{"jsonrpc":"2.0","id":1,"result":"hello"}`, res)
+
+	res = post(`[
+		{"jsonrpc":"2.0","id":1,"method":"test_echo","params":["a"]},
+		{"jsonrpc":"2.0","id":2,"method":"test_fail"},
+		{"jsonrpc":"2.0","method":"test_echo","params":["notification"]},
+		{"jsonrpc":"2.0","id":3,"method":"test_missing"}
+	]`)
+	require.JSONEq(t, `[
+		{"jsonrpc":"2.0","id":1,"result":"a"},
+		{"jsonrpc":"2.0","id":2,"error":{"code":-32000,"message":"failed"}},
+		{"jsonrpc":"2.0","id":3,"error":{"code":-32601,"message":"the method test_missing does not exist/is not available"}}
+	]`, res)
+
+	// the batch is served at once
+	require.Equal(t, 2, served)
+
+	require.Equal(t, int64(5), counter("jsonrpc/requests"))
+	require.Equal(t, int64(2), counter("jsonrpc/errors"))
+	require.Equal(t, int64(3), counter("jsonrpc/method/test_echo/requests"))
+	require.Equal(t, int64(0), counter("jsonrpc/method/test_echo/errors"))
+	require.Equal(t, int64(3), histogramCount("jsonrpc/method/test_echo/duration"))
+	require.Equal(t, int64(1), counter("jsonrpc/method/test_fail/errors"))
+	require.Equal(t, int64(1), counter("jsonrpc/method/unknown/errors"))
+	require.Nil(t, metrics.DefaultRegistry.Get("jsonrpc/method/test_missing/requests"))
+	require.Equal(t, int64(1), counter("jsonrpc/errors/32000"))
+	require.Equal(t, int64(1), counter("jsonrpc/errors/32601"))
+	require.Equal(t, int64(0), metrics.GetOrRegisterGauge("jsonrpc/inflight", nil).Value())
+}
+
+func TestHandlerResponseParts(t *testing.T) {
+	metrics.Enabled = true
+	metrics.DefaultRegistry.UnregisterAll()
+
+	// the response is written in several parts, with the same id answered twice
+	res := `[{"jsonrpc":"2.0","id":1,"result":"a"},{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"failed"}}]`
+	handler := rpcmetrics.New(nil).Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.Contains(t, string(body), "test_echo")
+		for i := 0; i < len(res); i += 10 {
+			_, _ = w.Write([]byte(res[i:min(i+10, len(res))]))
+		}
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`[
+		{"jsonrpc":"2.0","id":1,"method":"test_echo","params":["a"]},
+		{"jsonrpc":"2.0","id":1,"method":"test_echo","params":["b"]}
+	]`))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	require.Equal(t, res, rec.Body.String())
+
+	require.Equal(t, int64(2), counter("jsonrpc/method/unknown/requests"))
+	require.Equal(t, int64(1), counter("jsonrpc/method/unknown/errors"))
+	require.Equal(t, int64(1), counter("jsonrpc/errors/32000"))
+}
+
+func TestHandlerRejected(t *testing.T) {
+	metrics.Enabled = true
+	metrics.DefaultRegistry.UnregisterAll()
+
+	apis := []rpc.API{{Namespace: "test", Service: testService{}}}
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("test", testService{}))
+	limiter := ratelimit.NewLimiter(config.RateLimitConfig{Enable: true, RequestsPerSecond: 0.001, Burst: 2})
+	filter := methodfilter.NewFilter(nil, []string{"test_fail"})
+	// same order as the JSON-RPC server
+	handler := rpcmetrics.New(apis).Handler(limiter.Handler(filter.Handler(server)))
+
+	post := func(body string) {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+	}
+
+	post(`[{"jsonrpc":"2.0","id":1,"method":"test_echo","params":["a"]},{"jsonrpc":"2.0","id":2,"method":"test_fail"}]`)
+	post(`{"jsonrpc":"2.0","id":3,"method":"test_echo","params":["b"]}`)
+	post(`[{"jsonrpc":"2.0","id":4,"method":"test_echo","params":["c"]},{"jsonrpc":"2.0","id":5,"method":"test_echo","params":["d"]},{"jsonrpc":"2.0","id":6,"method":"test_echo","params":["e"]}]`)
+
+	require.Equal(t, int64(6), counter("jsonrpc/requests"))
+	require.Equal(t, int64(5), counter("jsonrpc/method/test_echo/requests"))
+	require.Equal(t, int64(1), counter("jsonrpc/method/test_fail/errors"))
+	require.Equal(t, int64(1), counter("jsonrpc/errors/32601"))
+	require.Equal(t, int64(1), counter("jsonrpc/errors/32005"))
+	require.Equal(t, int64(3), counter("jsonrpc/errors/32600"))
+}
+
+func TestRecord(t *testing.T) {
+	metrics.Enabled = true
+	metrics.DefaultRegistry.UnregisterAll()
+
+	m := rpcmetrics.New([]rpc.API{{Namespace: "test", Service: testService{}}})
+	body := jsonrpc.ParseBody([]byte(`[{"id":1,"method":"test_echo"},{"id":2,"method":"test_fail"}]`))
+	m.Record(body, body.ErrorResponses(ratelimit.ErrCodeLimitExceeded, ratelimit.ErrMsgLimitExceeded))
+
+	require.Equal(t, int64(2), counter("jsonrpc/requests"))
+	require.Equal(t, int64(1), counter("jsonrpc/method/test_echo/errors"))
+	require.Equal(t, int64(1), counter("jsonrpc/method/test_fail/errors"))
+	require.Equal(t, int64(2), counter("jsonrpc/errors/32005"))
+	require.Equal(t, int64(0), metrics.GetOrRegisterGauge("jsonrpc/inflight", nil).Value())
+
+	// a nil Metrics records nothing
+	var nilMetrics *rpcmetrics.Metrics
+	nilMetrics.Record(body, nil)
+}
+
+func TestSubscribed(t *testing.T) {
+	metrics.Enabled = true
+	metrics.DefaultRegistry.UnregisterAll()
+
+	m := rpcmetrics.New(nil)
+	gauge := func(typ string) int64 {
+		return metrics.GetOrRegisterGauge("jsonrpc/subscriptions/"+typ, nil).Value()
+	}
+
+	unsub1 := m.Subscribed("logs")
+	unsub2 := m.Subscribed("logs")
+	unsub3 := m.Subscribed("newHeads")
+	m.Subscribed("invalid")()
+	require.Equal(t, int64(2), gauge("logs"))
+	require.Equal(t, int64(1), gauge("newHeads"))
+	require.Nil(t, metrics.DefaultRegistry.Get("jsonrpc/subscriptions/invalid"))
+
+	unsub1()
+	unsub3()
+	require.Equal(t, int64(1), gauge("logs"))
+	require.Equal(t, int64(0), gauge("newHeads"))
+	unsub2()
+	require.Equal(t, int64(0), gauge("logs"))
+
+	// a nil Metrics records nothing
+	var nilMetrics *rpcmetrics.Metrics
+	nilMetrics.Subscribed("logs")()
+	nilMetrics.Begin("eth_call")(0)
+}
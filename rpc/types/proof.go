@@ -0,0 +1,183 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/cometbft/cometbft/crypto/merkle"
+	"github.com/cometbft/cometbft/proto/tendermint/crypto"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	ethermint "github.com/evmos/ethermint/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// StoreRootFromProof returns the root hash of the module store proven by the ABCI
+// query proof, i.e. the root computed from the IAVL commitment of the proof.
+// Ethermint keeps the storage of all the contracts in the single IAVL tree of the evm
+// module store, so the `storageHash` returned by `eth_getProof` is the root of this
+// tree, which is shared by all the accounts at a given height.
+func StoreRootFromProof(proof *crypto.ProofOps) (common.Hash, error) {
+	if proof == nil || len(proof.Ops) == 0 {
+		return common.Hash{}, errors.New("empty proof")
+	}
+
+	op, err := storetypes.CommitmentOpDecoder(proof.Ops[0])
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	root, err := op.(storetypes.CommitmentOp).Proof.Calculate()
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to calculate store root: %w", err)
+	}
+
+	return common.BytesToHash(root), nil
+}
+
+// VerifyAccountResult verifies the proofs of an `eth_getProof` result against the
+// trusted app hash of the block header following the queried height:
+//   - the account proof is verified against the auth module store and the proven
+//     account must match the returned nonce and code hash
+//   - the storage proofs are verified against the evm module store, whose root must
+//     match the returned storage hash
+//
+// NOTE: the balance is kept in the bank module store and isn't covered by the proofs.
+func VerifyAccountResult(cdc codec.BinaryCodec, res *AccountResult, appHash []byte) error {
+	accountKey := authtypes.AddressStoreKey(sdk.AccAddress(res.Address.Bytes()))
+	accountOps, err := decodeHexProof(res.AccountProof, authtypes.StoreKey, accountKey)
+	if err != nil {
+		return fmt.Errorf("invalid account proof: %w", err)
+	}
+
+	value, err := verifyProof(accountOps, authtypes.StoreKey, accountKey, appHash)
+	if err != nil {
+		return fmt.Errorf("invalid account proof: %w", err)
+	}
+
+	nonce := uint64(0)
+	codeHash := common.BytesToHash(evmtypes.EmptyCodeHash)
+	if value != nil {
+		var acc authtypes.AccountI
+		if err := cdc.UnmarshalInterface(value, &acc); err != nil {
+			return fmt.Errorf("failed to unmarshal proven account: %w", err)
+		}
+
+		nonce = acc.GetSequence()
+		if ethAcc, ok := acc.(ethermint.EthAccountI); ok {
+			codeHash = ethAcc.GetCodeHash()
+		}
+	}
+
+	if uint64(res.Nonce) != nonce {
+		return fmt.Errorf("nonce mismatch, proven %d, got %d", nonce, res.Nonce)
+	}
+	if res.CodeHash != codeHash {
+		return fmt.Errorf("code hash mismatch, proven %s, got %s", codeHash.Hex(), res.CodeHash.Hex())
+	}
+
+	for _, storage := range res.StorageProof {
+		key := evmtypes.StateKey(res.Address, common.HexToHash(storage.Key).Bytes())
+		storageOps, err := decodeHexProof(storage.Proof, evmtypes.StoreKey, key)
+		if err != nil {
+			return fmt.Errorf("invalid storage proof for key %s: %w", storage.Key, err)
+		}
+
+		root, err := StoreRootFromProof(storageOps)
+		if err != nil {
+			return fmt.Errorf("invalid storage proof for key %s: %w", storage.Key, err)
+		}
+		if root != res.StorageHash {
+			return fmt.Errorf("storage hash mismatch, proven %s, got %s", root.Hex(), res.StorageHash.Hex())
+		}
+
+		value, err := verifyProof(storageOps, evmtypes.StoreKey, key, appHash)
+		if err != nil {
+			return fmt.Errorf("invalid storage proof for key %s: %w", storage.Key, err)
+		}
+
+		var expValue common.Hash
+		if storage.Value != nil {
+			expValue = common.BigToHash(storage.Value.ToInt())
+		}
+		if common.BytesToHash(value) != expValue {
+			return fmt.Errorf("storage value mismatch for key %s, proven %x, got %s", storage.Key, value, expValue.Hex())
+		}
+	}
+
+	return nil
+}
+
+// decodeHexProof rebuilds the ABCI query proof of the key in the module store from
+// the hex encoded proofs returned by `eth_getProof`.
+func decodeHexProof(hexProofs []string, storeKey string, key []byte) (*crypto.ProofOps, error) {
+	if len(hexProofs) != 2 {
+		return nil, fmt.Errorf("expected 2 proof operations, got %d", len(hexProofs))
+	}
+
+	iavlProof, err := hexutil.Decode(hexProofs[0])
+	if err != nil {
+		return nil, err
+	}
+	storeProof, err := hexutil.Decode(hexProofs[1])
+	if err != nil {
+		return nil, err
+	}
+
+	return &crypto.ProofOps{
+		Ops: []crypto.ProofOp{
+			{Type: storetypes.ProofOpIAVLCommitment, Key: key, Data: iavlProof},
+			{Type: storetypes.ProofOpSimpleMerkleCommitment, Key: []byte(storeKey), Data: storeProof},
+		},
+	}, nil
+}
+
+// verifyProof verifies the existence or the absence of the key in the module store
+// against the app hash, it returns the proven value or nil if the key is absent.
+func verifyProof(proof *crypto.ProofOps, storeKey string, key, appHash []byte) ([]byte, error) {
+	op, err := storetypes.CommitmentOpDecoder(proof.Ops[0])
+	if err != nil {
+		return nil, err
+	}
+
+	keyPath := merkle.KeyPath{}.
+		AppendKey([]byte(storeKey), merkle.KeyEncodingURL).
+		AppendKey(key, merkle.KeyEncodingURL).
+		String()
+	prt := rootmulti.DefaultProofRuntime()
+
+	exist := op.(storetypes.CommitmentOp).Proof.GetExist()
+	if exist == nil {
+		return nil, prt.VerifyAbsence(proof, appHash, keyPath)
+	}
+
+	if !bytes.Equal(exist.Key, key) {
+		return nil, fmt.Errorf("proof is for key %x, expected %x", exist.Key, key)
+	}
+	if err := prt.VerifyValue(proof, appHash, keyPath, exist.Value); err != nil {
+		return nil, err
+	}
+	return exist.Value, nil
+}
@@ -0,0 +1,220 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/tests"
+	ethermint "github.com/evmos/ethermint/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+func TestVerifyAccountResult(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	authtypes.RegisterInterfaces(registry)
+	ethermint.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	address := tests.GenerateAddress()
+	codeHash := crypto.Keccak256Hash([]byte("code"))
+	slot := common.BigToHash(big.NewInt(1))
+	value := common.BigToHash(big.NewInt(2))
+
+	// commit the account and its storage to a multistore
+	accKey := storetypes.NewKVStoreKey(authtypes.StoreKey)
+	evmKey := storetypes.NewKVStoreKey(evmtypes.StoreKey)
+	rs := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger())
+	rs.MountStoreWithDB(accKey, storetypes.StoreTypeIAVL, nil)
+	rs.MountStoreWithDB(evmKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, rs.LoadLatestVersion())
+
+	acc := &ethermint.EthAccount{
+		BaseAccount: authtypes.NewBaseAccount(sdk.AccAddress(address.Bytes()), nil, 1, 5),
+		CodeHash:    codeHash.Hex(),
+	}
+	accBz, err := cdc.MarshalInterface(authtypes.AccountI(acc))
+	require.NoError(t, err)
+	rs.GetKVStore(accKey).Set(authtypes.AddressStoreKey(acc.GetAddress()), accBz)
+	rs.GetKVStore(evmKey).Set(evmtypes.StateKey(address, slot.Bytes()), value.Bytes())
+	commitID := rs.Commit()
+
+	query := func(storeKey string, key []byte) []string {
+		res := rs.Query(abci.RequestQuery{
+			Path:   "/" + storeKey + "/key",
+			Data:   key,
+			Height: commitID.Version,
+			Prove:  true,
+		})
+		require.Equal(t, uint32(0), res.Code, res.Log)
+
+		var proofs []string
+		for _, op := range res.ProofOps.Ops {
+			proofs = append(proofs, hexutil.Encode(op.Data))
+		}
+		return proofs
+	}
+
+	storageQuery := func(address common.Address, key common.Hash, value *big.Int) StorageResult {
+		return StorageResult{
+			Key:   key.Hex(),
+			Value: (*hexutil.Big)(value),
+			Proof: query(evmtypes.StoreKey, evmtypes.StateKey(address, key.Bytes())),
+		}
+	}
+
+	res := rs.Query(abci.RequestQuery{
+		Path:   "/" + evmtypes.StoreKey + "/key",
+		Data:   evmtypes.StateKey(address, slot.Bytes()),
+		Height: commitID.Version,
+		Prove:  true,
+	})
+	storageHash, err := StoreRootFromProof(res.ProofOps)
+	require.NoError(t, err)
+	require.NotEqual(t, common.Hash{}, storageHash)
+
+	absentAddress := tests.GenerateAddress()
+	emptySlot := common.BigToHash(big.NewInt(3))
+
+	testCases := []struct {
+		name     string
+		malleate func() *AccountResult
+		expPass  bool
+	}{
+		{
+			"pass - existing account and storage",
+			func() *AccountResult {
+				return &AccountResult{
+					Address:      address,
+					AccountProof: query(authtypes.StoreKey, authtypes.AddressStoreKey(acc.GetAddress())),
+					CodeHash:     codeHash,
+					Nonce:        5,
+					StorageHash:  storageHash,
+					StorageProof: []StorageResult{
+						storageQuery(address, slot, value.Big()),
+						storageQuery(address, emptySlot, big.NewInt(0)),
+					},
+				}
+			},
+			true,
+		},
+		{
+			"pass - absent account",
+			func() *AccountResult {
+				return &AccountResult{
+					Address:      absentAddress,
+					AccountProof: query(authtypes.StoreKey, authtypes.AddressStoreKey(sdk.AccAddress(absentAddress.Bytes()))),
+					CodeHash:     common.BytesToHash(evmtypes.EmptyCodeHash),
+					StorageHash:  storageHash,
+				}
+			},
+			true,
+		},
+		{
+			"fail - wrong nonce",
+			func() *AccountResult {
+				return &AccountResult{
+					Address:      address,
+					AccountProof: query(authtypes.StoreKey, authtypes.AddressStoreKey(acc.GetAddress())),
+					CodeHash:     codeHash,
+					Nonce:        6,
+				}
+			},
+			false,
+		},
+		{
+			"fail - wrong code hash",
+			func() *AccountResult {
+				return &AccountResult{
+					Address:      address,
+					AccountProof: query(authtypes.StoreKey, authtypes.AddressStoreKey(acc.GetAddress())),
+					CodeHash:     common.BytesToHash(evmtypes.EmptyCodeHash),
+					Nonce:        5,
+				}
+			},
+			false,
+		},
+		{
+			"fail - account proof of another address",
+			func() *AccountResult {
+				return &AccountResult{
+					Address:      absentAddress,
+					AccountProof: query(authtypes.StoreKey, authtypes.AddressStoreKey(acc.GetAddress())),
+					CodeHash:     codeHash,
+					Nonce:        5,
+				}
+			},
+			false,
+		},
+		{
+			"fail - wrong storage value",
+			func() *AccountResult {
+				return &AccountResult{
+					Address:      address,
+					AccountProof: query(authtypes.StoreKey, authtypes.AddressStoreKey(acc.GetAddress())),
+					CodeHash:     codeHash,
+					Nonce:        5,
+					StorageHash:  storageHash,
+					StorageProof: []StorageResult{storageQuery(address, slot, big.NewInt(3))},
+				}
+			},
+			false,
+		},
+		{
+			"fail - wrong storage hash",
+			func() *AccountResult {
+				return &AccountResult{
+					Address:      address,
+					AccountProof: query(authtypes.StoreKey, authtypes.AddressStoreKey(acc.GetAddress())),
+					CodeHash:     codeHash,
+					Nonce:        5,
+					StorageProof: []StorageResult{storageQuery(address, slot, value.Big())},
+				}
+			},
+			false,
+		},
+		{
+			"fail - invalid proof",
+			func() *AccountResult {
+				return &AccountResult{
+					Address:      address,
+					AccountProof: []string{""},
+				}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := VerifyAccountResult(cdc, tc.malleate(), commitID.Hash)
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+
+	// untrusted app hash
+	err = VerifyAccountResult(cdc, &AccountResult{
+		Address:      address,
+		AccountProof: query(authtypes.StoreKey, authtypes.AddressStoreKey(acc.GetAddress())),
+		CodeHash:     codeHash,
+		Nonce:        5,
+	}, crypto.Keccak256([]byte("app hash")))
+	require.Error(t, err)
+}
@@ -37,15 +37,22 @@ type QueryClient struct {
 	tx.ServiceClient
 	evmtypes.QueryClient
 	FeeMarket feemarkettypes.QueryClient
+	// Stream is the EVM query client of the streaming queries, which are only served by the
+	// gRPC server. It's nil if the gRPC client of the client context isn't set.
+	Stream evmtypes.QueryClient
 }
 
 // NewQueryClient creates a new gRPC query client
 func NewQueryClient(clientCtx client.Context) *QueryClient {
-	return &QueryClient{
+	queryClient := &QueryClient{
 		ServiceClient: tx.NewServiceClient(clientCtx),
 		QueryClient:   evmtypes.NewQueryClient(clientCtx),
 		FeeMarket:     feemarkettypes.NewQueryClient(clientCtx),
 	}
+	if clientCtx.GRPCClient != nil {
+		queryClient.Stream = evmtypes.NewQueryClient(clientCtx.GRPCClient)
+	}
+	return queryClient
 }
 
 // GetProof performs an ABCI query with the given key and returns a merkle proof. The desired
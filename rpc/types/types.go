@@ -21,6 +21,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
 )
 
 // Copied the Account and StorageResult types since they are registered under an
@@ -68,20 +70,61 @@ type RPCTransaction struct {
 }
 
 // StateOverride is the collection of overridden accounts.
-type StateOverride map[common.Address]OverrideAccount
+type StateOverride = evmtypes.StateOverride
 
 // OverrideAccount indicates the overriding fields of account during the execution of
 // a message call.
-// Note, state and stateDiff can't be specified at the same time. If state is
-// set, message execution will only use the data in the given state. Otherwise
-// if statDiff is set, all diff will be applied first and then execute the call
-// message.
-type OverrideAccount struct {
-	Nonce     *hexutil.Uint64              `json:"nonce"`
-	Code      *hexutil.Bytes               `json:"code"`
-	Balance   **hexutil.Big                `json:"balance"`
-	State     *map[common.Hash]common.Hash `json:"state"`
-	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
+type OverrideAccount = evmtypes.OverrideAccount
+
+// BlockOverrides is a set of header fields to override during the execution of
+// a message call.
+type BlockOverrides = evmtypes.BlockOverrides
+
+// TraceCallConfig is the config of `debug_traceCall`, it extends the tracer config
+// with the state and block overrides to apply before the call.
+type TraceCallConfig struct {
+	evmtypes.TraceConfig
+	StateOverrides *StateOverride  `json:"stateOverrides"`
+	BlockOverrides *BlockOverrides `json:"blockOverrides"`
+}
+
+// TraceFilterArgs are the inputs of `trace_filter`, the block range defaults to the
+// latest block and the empty address lists match any address.
+type TraceFilterArgs struct {
+	FromBlock   *BlockNumber     `json:"fromBlock"`
+	ToBlock     *BlockNumber     `json:"toBlock"`
+	FromAddress []common.Address `json:"fromAddress"`
+	ToAddress   []common.Address `json:"toAddress"`
+	After       *uint64          `json:"after"`
+	Count       *uint64          `json:"count"`
+}
+
+// SimOpts are the inputs of `eth_simulateV1`.
+type SimOpts struct {
+	BlockStateCalls        []evmtypes.SimBlock `json:"blockStateCalls"`
+	TraceTransfers         bool                `json:"traceTransfers"`
+	Validation             bool                `json:"validation"`
+	ReturnFullTransactions bool                `json:"returnFullTransactions"`
+}
+
+// AccessListResult is the result of `eth_createAccessList`.
+type AccessListResult struct {
+	Accesslist *ethtypes.AccessList `json:"accessList"`
+	Error      string               `json:"error,omitempty"`
+	GasUsed    hexutil.Uint64       `json:"gasUsed"`
+}
+
+// StorageRangeResult is the result of `debug_storageRangeAt`, the entries are keyed by
+// the hash of their storage key as in geth.
+type StorageRangeResult struct {
+	Storage map[common.Hash]StorageEntry `json:"storage"`
+	NextKey *common.Hash                 `json:"nextKey"` // nil if Storage includes the last key
+}
+
+// StorageEntry is a storage slot of `debug_storageRangeAt`
+type StorageEntry struct {
+	Key   *common.Hash `json:"key"`
+	Value common.Hash  `json:"value"`
 }
 
 type FeeHistoryResult struct {
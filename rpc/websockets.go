@@ -24,7 +24,9 @@ import (
 	"math/big"
 	"net"
 	"net/http"
+	"slices"
 	"sync"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/gorilla/mux"
@@ -32,6 +34,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/filters"
 	"github.com/ethereum/go-ethereum/params"
@@ -41,13 +44,35 @@ import (
 	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
 	tmtypes "github.com/cometbft/cometbft/types"
 
+	"github.com/evmos/ethermint/rpc/backend"
 	"github.com/evmos/ethermint/rpc/ethereum/pubsub"
+	"github.com/evmos/ethermint/rpc/jsonrpc"
+	"github.com/evmos/ethermint/rpc/methodfilter"
 	rpcfilters "github.com/evmos/ethermint/rpc/namespaces/ethereum/eth/filters"
+	"github.com/evmos/ethermint/rpc/ratelimit"
+	"github.com/evmos/ethermint/rpc/rpcmetrics"
 	"github.com/evmos/ethermint/rpc/types"
 	"github.com/evmos/ethermint/server/config"
+	ethermint "github.com/evmos/ethermint/types"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 )
 
+// errCodeInvalidRequest is the JSON-RPC error code of the errors sent by the websocket server
+const errCodeInvalidRequest = -32600
+
+// syncingPollInterval is the interval at which the sync status is polled for the syncing subscriptions
+const syncingPollInterval = time.Second
+
+const (
+	// traceBlockSubscription is the type of the subscriptions streaming the traces of a block
+	traceBlockSubscription = "traceBlock"
+	// traceBlockMethod is the method the traceBlock subscriptions are allowed and rate limited as
+	traceBlockMethod = "debug_traceBlockByNumber"
+)
+
+// errUnsubscribed stops the trace of the block of a cancelled traceBlock subscription
+var errUnsubscribed = errors.New("unsubscribed")
+
 type WebsocketsServer interface {
 	Start()
 }
@@ -69,6 +94,13 @@ type SubscriptionResult struct {
 	Result       interface{} `json:"result"`
 }
 
+// SyncingResult is the notification of the syncing subscription, the status is the
+// progress reported by eth_syncing and is omitted once the node is synced.
+type SyncingResult struct {
+	Syncing bool                   `json:"syncing"`
+	Status  map[string]interface{} `json:"status,omitempty"`
+}
+
 type ErrorResponseJSON struct {
 	Jsonrpc string            `json:"jsonrpc"`
 	Error   *ErrorMessageJSON `json:"error"`
@@ -80,25 +112,54 @@ type ErrorMessageJSON struct {
 	Message string   `json:"message"`
 }
 
+// TraceBlockResult is the notification of a traceBlock subscription, it carries the trace of a
+// transaction of the block, then the end of the traces with the error of the block trace if any.
+type TraceBlockResult struct {
+	TxIndex *hexutil.Uint           `json:"txIndex,omitempty"`
+	Trace   *evmtypes.TxTraceResult `json:"trace,omitempty"`
+	Done    bool                    `json:"done,omitempty"`
+	Error   string                  `json:"error,omitempty"`
+}
+
 type websocketsServer struct {
 	rpcAddr  string // listen address of rest-server
 	wsAddr   string // listen address of ws server
 	certFile string
 	keyFile  string
 	api      *pubSubAPI
+	filter   *methodfilter.Filter // nil if all the methods are served
+	limiter  *ratelimit.Limiter   // nil if the requests are not rate limited
+	metrics  *rpcmetrics.Metrics  // nil if the metrics are disabled
 	logger   log.Logger
 }
 
-func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, tmWSClient *rpcclient.WSClient, cfg *config.Config) WebsocketsServer {
+func NewWebsocketsServer(
+	clientCtx client.Context,
+	logger log.Logger,
+	tmWSClient *rpcclient.WSClient,
+	evmBackend backend.EVMBackend,
+	filter *methodfilter.Filter,
+	limiter *ratelimit.Limiter,
+	rpcMetrics *rpcmetrics.Metrics,
+	cfg *config.Config,
+) WebsocketsServer {
 	logger = logger.With("api", "websocket-server")
 	_, port, _ := net.SplitHostPort(cfg.JSONRPC.Address)
 
+	api := newPubSubAPI(clientCtx, logger, tmWSClient, evmBackend)
+	// the blocks are only traced if the debug namespace is served by the public server
+	api.traceBlock = cfg.JSONRPC.AuthAddress == "" && slices.Contains(cfg.JSONRPC.API, DebugNamespace) &&
+		(filter == nil || filter.Allowed(traceBlockMethod))
+
 	return &websocketsServer{
 		rpcAddr:  "localhost:" + port, // FIXME: this shouldn't be hardcoded to localhost
 		wsAddr:   cfg.JSONRPC.WsAddress,
 		certFile: cfg.TLS.CertificatePath,
 		keyFile:  cfg.TLS.KeyPath,
-		api:      newPubSubAPI(clientCtx, logger, tmWSClient),
+		api:      api,
+		filter:   filter,
+		limiter:  limiter,
+		metrics:  rpcMetrics,
 		logger:   logger,
 	}
 }
@@ -142,14 +203,14 @@ func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	s.readLoop(&wsConn{
 		mux:  new(sync.Mutex),
 		conn: conn,
-	})
+	}, s.limiter.ClientIP(r))
 }
 
 func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) {
 	res := &ErrorResponseJSON{
 		Jsonrpc: "2.0",
 		Error: &ErrorMessageJSON{
-			Code:    big.NewInt(-32600),
+			Code:    big.NewInt(errCodeInvalidRequest),
 			Message: msg,
 		},
 		ID: nil,
@@ -183,7 +244,7 @@ func (w *wsConn) ReadMessage() (messageType int, p []byte, err error) {
 	return w.conn.ReadMessage()
 }
 
-func (s *websocketsServer) readLoop(wsConn *wsConn) {
+func (s *websocketsServer) readLoop(wsConn *wsConn, clientIP string) {
 	// subscriptions of current connection
 	subscriptions := make(map[rpc.ID]pubsub.UnsubscribeFunc)
 	defer func() {
@@ -201,7 +262,26 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 			return
 		}
 
-		if isBatch(mb) {
+		if s.limiter != nil || s.filter != nil {
+			body := jsonrpc.ParseBody(mb)
+			if s.limiter != nil {
+				if res := s.limiter.Reject(clientIP, body); res != nil {
+					s.metrics.Record(body, res)
+					_ = wsConn.WriteJSON(res)
+					continue
+				}
+			}
+			// the batches are filtered by the rpc server they are forwarded to
+			if s.filter != nil && !body.Batch {
+				if res, filtered := s.filter.Filtered(body.Requests[0]); filtered {
+					s.metrics.Record(body, res)
+					_ = wsConn.WriteJSON(res)
+					continue
+				}
+			}
+		}
+
+		if jsonrpc.IsBatch(mb) {
 			if err := s.tcpGetAndSendResponse(wsConn, mb); err != nil {
 				s.sendErrResponse(wsConn, err.Error())
 			}
@@ -236,18 +316,36 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 
 		switch method {
 		case "eth_subscribe":
+			done := s.metrics.Begin(method)
 			params, ok := s.getParamsAndCheckValid(msg, wsConn)
 			if !ok {
+				done(errCodeInvalidRequest)
+				continue
+			}
+
+			subType, _ := params[0].(string)
+			if subType == traceBlockSubscription && s.limiter != nil && !s.limiter.Allow(clientIP, traceBlockMethod) {
+				// the trace of a block weighs the same as the method tracing it
+				s.sendErrResponse(wsConn, ratelimit.ErrMsgLimitExceeded)
+				done(ratelimit.ErrCodeLimitExceeded)
 				continue
 			}
 
 			subID := rpc.NewID()
-			unsubFn, err := s.api.subscribe(wsConn, subID, params)
+			// the notifications are written once the subscription id is sent
+			subscribed := make(chan struct{})
+			unsubFn, err := s.api.subscribe(wsConn, subID, params, subscribed)
 			if err != nil {
 				s.sendErrResponse(wsConn, err.Error())
+				done(errCodeInvalidRequest)
 				continue
 			}
-			subscriptions[subID] = unsubFn
+			unsubscribed := s.metrics.Subscribed(subType)
+			subscriptions[subID] = func() {
+				unsubFn()
+				unsubscribed()
+			}
+			done(0)
 
 			res := &SubscriptionResponseJSON{
 				Jsonrpc: "2.0",
@@ -255,18 +353,23 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 				Result:  subID,
 			}
 
-			if err := wsConn.WriteJSON(res); err != nil {
+			err = wsConn.WriteJSON(res)
+			close(subscribed)
+			if err != nil {
 				break
 			}
 		case "eth_unsubscribe":
+			done := s.metrics.Begin(method)
 			params, ok := s.getParamsAndCheckValid(msg, wsConn)
 			if !ok {
+				done(errCodeInvalidRequest)
 				continue
 			}
 
 			id, ok := params[0].(string)
 			if !ok {
 				s.sendErrResponse(wsConn, "invalid parameters")
+				done(errCodeInvalidRequest)
 				continue
 			}
 
@@ -276,6 +379,7 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 				delete(subscriptions, subID)
 				unsubFn()
 			}
+			done(0)
 
 			res := &SubscriptionResponseJSON{
 				Jsonrpc: "2.0",
@@ -320,6 +424,10 @@ func (s *websocketsServer) tcpGetAndSendResponse(wsConn *wsConn, mb []byte) erro
 	}
 
 	req.Header.Set("Content-Type", "application/json")
+	if s.limiter != nil {
+		// the request is already limited by the websocket server
+		req.Header.Set(ratelimit.BypassHeader, s.limiter.BypassToken())
+	}
 	client := &http.Client{}
 	resp, err := client.Do(req)
 	if err != nil {
@@ -347,19 +455,27 @@ type pubSubAPI struct {
 	events    *rpcfilters.EventSystem
 	logger    log.Logger
 	clientCtx client.Context
+	backend   backend.EVMBackend
+	syncing   *syncingPoller
+	// traceBlock is true if the traceBlock subscriptions are served
+	traceBlock bool
 }
 
 // newPubSubAPI creates an instance of the ethereum PubSub API.
-func newPubSubAPI(clientCtx client.Context, logger log.Logger, tmWSClient *rpcclient.WSClient) *pubSubAPI {
+func newPubSubAPI(clientCtx client.Context, logger log.Logger, tmWSClient *rpcclient.WSClient, evmBackend backend.EVMBackend) *pubSubAPI {
 	logger = logger.With("module", "websocket-client")
 	return &pubSubAPI{
 		events:    rpcfilters.NewEventSystem(logger, tmWSClient),
 		logger:    logger,
 		clientCtx: clientCtx,
+		backend:   evmBackend,
+		syncing:   newSyncingPoller(logger, evmBackend.Syncing, syncingPollInterval),
 	}
 }
 
-func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []interface{}) (pubsub.UnsubscribeFunc, error) {
+// subscribe creates the subscription of the params, the notifications which don't follow an
+// event are only written once subscribed is closed.
+func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []interface{}, subscribed <-chan struct{}) (pubsub.UnsubscribeFunc, error) {
 	method, ok := params[0].(string)
 	if !ok {
 		return nil, errors.New("invalid parameters")
@@ -375,14 +491,161 @@ func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []interface
 		}
 		return api.subscribeLogs(wsConn, subID, nil)
 	case "newPendingTransactions":
-		return api.subscribePendingTransactions(wsConn, subID)
+		fullTx, criteria, err := parsePendingTxParams(params[1:])
+		if err != nil {
+			return nil, err
+		}
+		return api.subscribePendingTransactions(wsConn, subID, fullTx, criteria)
 	case "syncing":
 		return api.subscribeSyncing(wsConn, subID)
+	case traceBlockSubscription:
+		if !api.traceBlock {
+			return nil, errors.Errorf("unsupported method %s", method)
+		}
+		height, config, err := parseTraceBlockParams(params[1:])
+		if err != nil {
+			return nil, err
+		}
+		return api.subscribeTraceBlock(wsConn, subID, height, config, subscribed)
 	default:
 		return nil, errors.Errorf("unsupported method %s", method)
 	}
 }
 
+// parsePendingTxParams parses the optional fullTx flag and criteria of a pending transactions
+// subscription.
+func parsePendingTxParams(params []interface{}) (bool, *rpcfilters.PendingTxCriteria, error) {
+	var fullTx bool
+	if len(params) > 0 && params[0] != nil {
+		var ok bool
+		if fullTx, ok = params[0].(bool); !ok {
+			return false, nil, errors.New("invalid fullTx parameter; must be a boolean")
+		}
+	}
+	var criteria *rpcfilters.PendingTxCriteria
+	if len(params) > 1 && params[1] != nil {
+		bz, err := json.Marshal(params[1])
+		if err != nil {
+			return false, nil, errors.Wrap(err, "invalid criteria")
+		}
+		if err := json.Unmarshal(bz, &criteria); err != nil {
+			return false, nil, errors.Wrap(err, "invalid criteria")
+		}
+	}
+	return fullTx, criteria, nil
+}
+
+// parseTraceBlockParams parses the block number and the optional trace config of a traceBlock
+// subscription.
+func parseTraceBlockParams(params []interface{}) (types.BlockNumber, *evmtypes.TraceConfig, error) {
+	if len(params) == 0 {
+		return 0, nil, errors.New("missing block number parameter")
+	}
+	bz, err := json.Marshal(params[0])
+	if err != nil {
+		return 0, nil, err
+	}
+	var height types.BlockNumber
+	if err := json.Unmarshal(bz, &height); err != nil {
+		return 0, nil, errors.Wrap(err, "invalid block number")
+	}
+
+	var config *evmtypes.TraceConfig
+	if len(params) > 1 && params[1] != nil {
+		bz, err := json.Marshal(params[1])
+		if err != nil {
+			return 0, nil, err
+		}
+		if err := json.Unmarshal(bz, &config); err != nil {
+			return 0, nil, errors.Wrap(err, "invalid trace config")
+		}
+	}
+	return height, config, nil
+}
+
+// subscribeTraceBlock traces the block and notifies the trace of each of its transactions in
+// order as soon as it's traced, then the end of the traces. The trace is stopped if the
+// subscription is cancelled.
+func (api *pubSubAPI) subscribeTraceBlock(
+	wsConn *wsConn,
+	subID rpc.ID,
+	height types.BlockNumber,
+	config *evmtypes.TraceConfig,
+	subscribed <-chan struct{},
+) (pubsub.UnsubscribeFunc, error) {
+	if height == 0 {
+		return nil, errors.New("genesis is not traceable")
+	}
+	resBlock, err := api.backend.TendermintBlockByNumber(height)
+	if err != nil {
+		return nil, err
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, errors.New("block not found")
+	}
+
+	unsubscribed := make(chan struct{})
+	var once sync.Once
+	unsubFn := func() {
+		once.Do(func() { close(unsubscribed) })
+	}
+
+	go func() {
+		select {
+		case <-subscribed:
+		case <-unsubscribed:
+			return
+		}
+
+		notify := func(result *TraceBlockResult) error {
+			return wsConn.WriteJSON(&SubscriptionNotification{
+				Jsonrpc: "2.0",
+				Method:  "eth_subscription",
+				Params: &SubscriptionResult{
+					Subscription: subID,
+					Result:       result,
+				},
+			})
+		}
+
+		var txIndex hexutil.Uint
+		var writeErr error
+		err := api.backend.TraceBlockStream(types.BlockNumber(resBlock.Block.Height), config, resBlock, func(trace *evmtypes.TxTraceResult) error {
+			select {
+			case <-unsubscribed:
+				return errUnsubscribed
+			default:
+			}
+			index := txIndex
+			txIndex++
+			writeErr = notify(&TraceBlockResult{TxIndex: &index, Trace: trace})
+			return writeErr
+		})
+		if err == errUnsubscribed {
+			return
+		}
+
+		if writeErr == nil {
+			result := &TraceBlockResult{Done: true}
+			if err != nil {
+				result.Error = err.Error()
+			}
+			writeErr = notify(result)
+		}
+		if writeErr != nil {
+			api.logger.Debug("error writing block trace, will drop peer", "error", writeErr.Error())
+
+			try(func() {
+				if writeErr != websocket.ErrCloseSent {
+					_ = wsConn.Close()
+				}
+			}, api.logger, "closing websocket peer sub")
+		}
+	}()
+
+	return unsubFn, nil
+}
+
 func (api *pubSubAPI) subscribeNewHeads(wsConn *wsConn, subID rpc.ID) (pubsub.UnsubscribeFunc, error) {
 	sub, unsubFn, err := api.events.SubscribeNewHeads()
 	if err != nil {
@@ -623,7 +886,19 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 	return unsubFn, nil
 }
 
-func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID) (pubsub.UnsubscribeFunc, error) {
+func (api *pubSubAPI) subscribePendingTransactions(
+	wsConn *wsConn,
+	subID rpc.ID,
+	fullTx bool,
+	criteria *rpcfilters.PendingTxCriteria,
+) (pubsub.UnsubscribeFunc, error) {
+	chainID, err := ethermint.ParseChainID(api.clientCtx.ChainID)
+	if err != nil {
+		return nil, err
+	}
+
+	signer := ethtypes.LatestSignerForChainID(chainID)
+
 	sub, unsubFn, err := api.events.SubscribePendingTxs()
 	if err != nil {
 		return nil, errors.Wrap(err, "error creating block filter: %s")
@@ -641,20 +916,14 @@ func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID)
 					continue
 				}
 
-				ethTxs, err := types.RawTxToEthTx(api.clientCtx, data.Tx)
-				if err != nil {
-					// not ethereum tx
-					continue
-				}
-
-				for _, ethTx := range ethTxs {
+				for _, result := range api.pendingTxResults(data.Tx, fullTx, criteria, signer, chainID) {
 					// write to ws conn
 					res := &SubscriptionNotification{
 						Jsonrpc: "2.0",
 						Method:  "eth_subscription",
 						Params: &SubscriptionResult{
 							Subscription: subID,
-							Result:       ethTx.Hash,
+							Result:       result,
 						},
 					}
 
@@ -681,19 +950,164 @@ func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID)
 	return unsubFn, nil
 }
 
-func (api *pubSubAPI) subscribeSyncing(wsConn *wsConn, subID rpc.ID) (pubsub.UnsubscribeFunc, error) { //nolint: revive
-	return nil, errors.New("syncing subscription is not implemented")
-}
+// pendingTxResults returns the notification results of the ethereum txs of the tx bytes which
+// match the criteria, the tx bytes of the other txs have none.
+func (api *pubSubAPI) pendingTxResults(
+	txBz tmtypes.Tx,
+	fullTx bool,
+	criteria *rpcfilters.PendingTxCriteria,
+	signer ethtypes.Signer,
+	chainID *big.Int,
+) []interface{} {
+	ethTxs, err := types.RawTxToEthTx(api.clientCtx, txBz)
+	if err != nil {
+		// not ethereum tx
+		return nil
+	}
+
+	var results []interface{}
+	for _, ethMsg := range ethTxs {
+		ethTx := ethMsg.AsTransaction()
+		if !criteria.Matches(ethTx, signer) {
+			continue
+		}
 
-// copy from github.com/ethereum/go-ethereum/rpc/json.go
-// isBatch returns true when the first non-whitespace characters is '['
-func isBatch(raw []byte) bool {
-	for _, c := range raw {
-		// skip insignificant whitespace (http://www.ietf.org/rfc/rfc4627.txt)
-		if c == 0x20 || c == 0x09 || c == 0x0a || c == 0x0d {
+		result, err := rpcfilters.PendingTxResult(ethTx, fullTx, chainID)
+		if err != nil {
+			api.logger.Debug("failed to build pending tx", "error", err.Error())
 			continue
 		}
-		return c == '['
+		results = append(results, result)
+	}
+	return results
+}
+
+func (api *pubSubAPI) subscribeSyncing(wsConn *wsConn, subID rpc.ID) (pubsub.UnsubscribeFunc, error) {
+	statusCh, unsubFn := api.syncing.subscribe()
+
+	go func() {
+		syncing := false
+		for result := range statusCh {
+			// only notify on the start and the end of the sync
+			if result.Syncing == syncing {
+				continue
+			}
+			syncing = result.Syncing
+
+			// write to ws conn
+			res := &SubscriptionNotification{
+				Jsonrpc: "2.0",
+				Method:  "eth_subscription",
+				Params: &SubscriptionResult{
+					Subscription: subID,
+					Result:       result,
+				},
+			}
+
+			err := wsConn.WriteJSON(res)
+			if err != nil {
+				api.logger.Debug("error writing syncing status, will drop peer", "error", err.Error())
+
+				try(func() {
+					if err != websocket.ErrCloseSent {
+						_ = wsConn.Close()
+					}
+				}, api.logger, "closing websocket peer sub")
+				return
+			}
+		}
+	}()
+
+	return unsubFn, nil
+}
+
+// syncingPoller polls the sync status once for all the syncing subscriptions, the polling
+// only runs while there are subscriptions.
+type syncingPoller struct {
+	logger   log.Logger
+	syncing  func() (interface{}, error)
+	interval time.Duration
+
+	mu   sync.Mutex
+	subs map[chan *SyncingResult]struct{}
+	stop chan struct{} // closed to stop the polling, nil if the polling isn't running
+}
+
+func newSyncingPoller(logger log.Logger, syncing func() (interface{}, error), interval time.Duration) *syncingPoller {
+	return &syncingPoller{
+		logger:   logger,
+		syncing:  syncing,
+		interval: interval,
+		subs:     make(map[chan *SyncingResult]struct{}),
+	}
+}
+
+// subscribe returns a channel receiving the sync status after each poll and the function
+// closing it. The first poll happens after a full interval so that no notification is
+// written before the subscription id is returned to the client, and only the latest status
+// is kept for the subscribers not keeping up.
+func (p *syncingPoller) subscribe() (<-chan *SyncingResult, pubsub.UnsubscribeFunc) {
+	ch := make(chan *SyncingResult, 1)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.subs[ch] = struct{}{}
+	if p.stop == nil {
+		p.stop = make(chan struct{})
+		go p.poll(p.stop)
+	}
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			p.mu.Lock()
+			defer p.mu.Unlock()
+			delete(p.subs, ch)
+			close(ch)
+			if len(p.subs) == 0 {
+				close(p.stop)
+				p.stop = nil
+			}
+		})
+	}
+}
+
+func (p *syncingPoller) poll(stop chan struct{}) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			status, err := p.syncing()
+			if err != nil {
+				p.logger.Debug("failed to get syncing status", "error", err.Error())
+				continue
+			}
+
+			result := &SyncingResult{}
+			if progress, ok := status.(map[string]interface{}); ok {
+				result.Syncing = true
+				result.Status = progress
+			}
+
+			p.mu.Lock()
+			if p.stop != stop {
+				// stopped while polling
+				p.mu.Unlock()
+				return
+			}
+			for ch := range p.subs {
+				// replace the status not received yet, the channels are only written here
+				select {
+				case <-ch:
+				default:
+				}
+				ch <- result
+			}
+			p.mu.Unlock()
+		}
 	}
-	return false
 }
@@ -0,0 +1,337 @@
+package rpc
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/app"
+	"github.com/evmos/ethermint/encoding"
+	"github.com/evmos/ethermint/rpc/backend"
+	rpcfilters "github.com/evmos/ethermint/rpc/namespaces/ethereum/eth/filters"
+	"github.com/evmos/ethermint/rpc/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+const testPollInterval = 20 * time.Millisecond
+
+// fakeSyncing returns the statuses in order, then the last one
+type fakeSyncing struct {
+	mu       sync.Mutex
+	statuses []interface{}
+	polls    int
+}
+
+func (f *fakeSyncing) Syncing() (interface{}, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	status := f.statuses[0]
+	if len(f.statuses) > 1 {
+		f.statuses = f.statuses[1:]
+	}
+	f.polls++
+	return status, nil
+}
+
+func (f *fakeSyncing) Polls() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.polls
+}
+
+// dialWsConn returns a server side websocket connection and its client side.
+func dialWsConn(t *testing.T) (*wsConn, *websocket.Conn) {
+	connCh := make(chan *websocket.Conn, 1)
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		require.NoError(t, err)
+		connCh <- conn
+	}))
+	t.Cleanup(server.Close)
+
+	client, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { client.Close() })
+	return &wsConn{conn: <-connCh, mux: new(sync.Mutex)}, client
+}
+
+func TestSyncingPoller(t *testing.T) {
+	progress := map[string]interface{}{"currentBlock": "0x1"}
+	backend := &fakeSyncing{statuses: []interface{}{progress, false}}
+	poller := newSyncingPoller(log.NewNopLogger(), backend.Syncing, testPollInterval)
+
+	// the subscriptions share the polls
+	ch1, unsub1 := poller.subscribe()
+	ch2, unsub2 := poller.subscribe()
+	require.Equal(t, &SyncingResult{Syncing: true, Status: progress}, <-ch1)
+	require.Equal(t, &SyncingResult{Syncing: true, Status: progress}, <-ch2)
+	require.Equal(t, &SyncingResult{}, <-ch1)
+	require.Equal(t, &SyncingResult{}, <-ch2)
+	require.LessOrEqual(t, backend.Polls(), 3)
+
+	// the channel of a cancelled subscription is closed
+	unsub1()
+	unsub1()
+	_, ok := <-ch1
+	require.False(t, ok)
+	require.Equal(t, &SyncingResult{}, <-ch2)
+
+	// the polling stops with the last subscription and restarts with the next one
+	unsub2()
+	polls := backend.Polls()
+	time.Sleep(5 * testPollInterval)
+	require.Equal(t, polls, backend.Polls())
+
+	ch3, unsub3 := poller.subscribe()
+	defer unsub3()
+	require.Equal(t, &SyncingResult{}, <-ch3)
+}
+
+func TestSubscribeSyncing(t *testing.T) {
+	progress := map[string]interface{}{"currentBlock": "0x1"}
+	backend := &fakeSyncing{statuses: []interface{}{false, false, progress, progress, false}}
+	api := &pubSubAPI{
+		logger:  log.NewNopLogger(),
+		syncing: newSyncingPoller(log.NewNopLogger(), backend.Syncing, testPollInterval),
+	}
+
+	conn, client := dialWsConn(t)
+	subID := rpc.ID("0x1")
+	unsubFn, err := api.subscribeSyncing(conn, subID)
+	require.NoError(t, err)
+	defer unsubFn()
+
+	readResult := func() *SyncingResult {
+		var notification struct {
+			Params struct {
+				Subscription rpc.ID         `json:"subscription"`
+				Result       *SyncingResult `json:"result"`
+			} `json:"params"`
+		}
+		require.NoError(t, client.SetReadDeadline(time.Now().Add(time.Second)))
+		_, bz, err := client.ReadMessage()
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(bz, &notification))
+		require.Equal(t, subID, notification.Params.Subscription)
+		return notification.Params.Result
+	}
+
+	// only the start and the end of the sync are notified
+	require.Equal(t, &SyncingResult{Syncing: true, Status: progress}, readResult())
+	require.Equal(t, &SyncingResult{}, readResult())
+
+	require.NoError(t, client.SetReadDeadline(time.Now().Add(10*testPollInterval)))
+	_, _, err = client.ReadMessage()
+	require.Error(t, err)
+}
+
+func TestParsePendingTxParams(t *testing.T) {
+	from := common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec")
+
+	testCases := []struct {
+		name        string
+		params      string
+		expFullTx   bool
+		expCriteria *rpcfilters.PendingTxCriteria
+		expErrMsg   string
+	}{
+		{"no params", `[]`, false, nil, ""},
+		{"fullTx", `[true]`, true, nil, ""},
+		{"null fullTx", `[null]`, false, nil, ""},
+		{"fullTx and criteria", `[false, {"from": ["` + from.Hex() + `"]}]`, false, &rpcfilters.PendingTxCriteria{From: []common.Address{from}}, ""},
+		{"null criteria", `[true, null]`, true, nil, ""},
+		{"invalid fullTx", `["true"]`, false, nil, "invalid fullTx parameter"},
+		{"invalid criteria", `[true, {"to": "0x1"}]`, false, nil, "invalid criteria"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var params []interface{}
+			require.NoError(t, json.Unmarshal([]byte(tc.params), &params))
+
+			fullTx, criteria, err := parsePendingTxParams(params)
+			if tc.expErrMsg != "" {
+				require.ErrorContains(t, err, tc.expErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expFullTx, fullTx)
+			require.Equal(t, tc.expCriteria, criteria)
+		})
+	}
+}
+
+// fakeTraceBlock streams the traces of a block, then err
+type fakeTraceBlock struct {
+	backend.EVMBackend
+	traces []*evmtypes.TxTraceResult
+	err    error
+}
+
+func (f *fakeTraceBlock) TendermintBlockByNumber(blockNum types.BlockNumber) (*tmrpctypes.ResultBlock, error) {
+	return &tmrpctypes.ResultBlock{Block: &tmtypes.Block{Header: tmtypes.Header{Height: blockNum.Int64()}}}, nil
+}
+
+func (f *fakeTraceBlock) TraceBlockStream(_ types.BlockNumber, _ *evmtypes.TraceConfig, _ *tmrpctypes.ResultBlock, fn func(*evmtypes.TxTraceResult) error) error {
+	for _, trace := range f.traces {
+		if err := fn(trace); err != nil {
+			return err
+		}
+	}
+	return f.err
+}
+
+func TestSubscribeTraceBlock(t *testing.T) {
+	traces := []*evmtypes.TxTraceResult{{Result: "first"}, {Error: "second"}}
+	firstIndex, secondIndex := hexutil.Uint(0), hexutil.Uint(1)
+
+	testCases := []struct {
+		name       string
+		traceBlock bool
+		params     string
+		err        error
+		expResults []*TraceBlockResult
+		expErrMsg  string
+	}{
+		{
+			"block traced", true, `["traceBlock", "0x2"]`, nil,
+			[]*TraceBlockResult{
+				{TxIndex: &firstIndex, Trace: traces[0]},
+				{TxIndex: &secondIndex, Trace: traces[1]},
+				{Done: true},
+			},
+			"",
+		},
+		{
+			"block trace failing", true, `["traceBlock", "0x2", {"tracer": "callTracer"}]`, errors.New("trace failed"),
+			[]*TraceBlockResult{
+				{TxIndex: &firstIndex, Trace: traces[0]},
+				{TxIndex: &secondIndex, Trace: traces[1]},
+				{Done: true, Error: "trace failed"},
+			},
+			"",
+		},
+		{"fail - not served", false, `["traceBlock", "0x2"]`, nil, nil, "unsupported method traceBlock"},
+		{"fail - missing block number", true, `["traceBlock"]`, nil, nil, "missing block number"},
+		{"fail - invalid trace config", true, `["traceBlock", "0x2", {"tracer": 1}]`, nil, nil, "invalid trace config"},
+		{"fail - genesis", true, `["traceBlock", "0x0"]`, nil, nil, "genesis is not traceable"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			api := &pubSubAPI{
+				logger:     log.NewNopLogger(),
+				backend:    &fakeTraceBlock{traces: traces, err: tc.err},
+				traceBlock: tc.traceBlock,
+			}
+			var params []interface{}
+			require.NoError(t, json.Unmarshal([]byte(tc.params), &params))
+
+			// nothing is written before the subscription id is sent
+			conn, client := dialWsConn(t)
+			subID := rpc.ID("0x1")
+			unsubFn, err := api.subscribe(conn, subID, params, make(chan struct{}))
+			if tc.expErrMsg != "" {
+				require.ErrorContains(t, err, tc.expErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.NoError(t, client.SetReadDeadline(time.Now().Add(5*testPollInterval)))
+			_, _, err = client.ReadMessage()
+			require.Error(t, err)
+			unsubFn()
+
+			conn, client = dialWsConn(t)
+			subscribed := make(chan struct{})
+			close(subscribed)
+			unsubFn, err = api.subscribe(conn, subID, params, subscribed)
+			require.NoError(t, err)
+			defer unsubFn()
+
+			for _, expResult := range tc.expResults {
+				var notification struct {
+					Params struct {
+						Subscription rpc.ID            `json:"subscription"`
+						Result       *TraceBlockResult `json:"result"`
+					} `json:"params"`
+				}
+				require.NoError(t, client.SetReadDeadline(time.Now().Add(time.Second)))
+				_, bz, err := client.ReadMessage()
+				require.NoError(t, err)
+				require.NoError(t, json.Unmarshal(bz, &notification))
+				require.Equal(t, subID, notification.Params.Subscription)
+				require.Equal(t, expResult, notification.Params.Result)
+			}
+		})
+	}
+}
+
+func TestPendingTxResults(t *testing.T) {
+	encodingConfig := encoding.MakeConfig(app.ModuleBasics)
+	api := &pubSubAPI{
+		logger:    log.NewNopLogger(),
+		clientCtx: client.Context{}.WithTxConfig(encodingConfig.TxConfig),
+	}
+	chainID := big.NewInt(9000)
+	signer := ethtypes.LatestSignerForChainID(chainID)
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	to := common.BigToAddress(big.NewInt(2))
+	ethTx, err := ethtypes.SignNewTx(key, signer, &ethtypes.LegacyTx{To: &to, Gas: 21000, GasPrice: big.NewInt(1)})
+	require.NoError(t, err)
+
+	// the chain delivers the txs with an empty From, the sender is only in the signature
+	msg := &evmtypes.MsgEthereumTx{}
+	require.NoError(t, msg.FromEthereumTx(ethTx))
+	require.Empty(t, msg.From)
+	txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(msg))
+	txBz, err := encodingConfig.TxConfig.TxEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+
+	other := common.BigToAddress(big.NewInt(3))
+	testCases := []struct {
+		name       string
+		criteria   *rpcfilters.PendingTxCriteria
+		expResults []interface{}
+	}{
+		{"no criteria", nil, []interface{}{ethTx.Hash()}},
+		{"from matches", &rpcfilters.PendingTxCriteria{From: []common.Address{from}}, []interface{}{ethTx.Hash()}},
+		{"from doesn't match", &rpcfilters.PendingTxCriteria{From: []common.Address{other}}, nil},
+		{"to matches", &rpcfilters.PendingTxCriteria{To: []common.Address{to}}, []interface{}{ethTx.Hash()}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expResults, api.pendingTxResults(txBz, false, tc.criteria, signer, chainID))
+		})
+	}
+
+	// the full tx is built with the recovered sender
+	results := api.pendingTxResults(txBz, true, &rpcfilters.PendingTxCriteria{From: []common.Address{from}}, signer, chainID)
+	require.Len(t, results, 1)
+	rpcTx, ok := results[0].(*types.RPCTransaction)
+	require.True(t, ok)
+	require.Equal(t, from, rpcTx.From)
+}
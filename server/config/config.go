@@ -18,9 +18,11 @@ package config
 import (
 	"errors"
 	"fmt"
+	"net"
 	"path"
 	"time"
 
+	"github.com/spf13/cast"
 	"github.com/spf13/viper"
 
 	"github.com/cometbft/cometbft/libs/strings"
@@ -40,6 +42,10 @@ const (
 	// DefaultJSONRPCWsAddress is the default address the JSON-RPC WebSocket server binds to.
 	DefaultJSONRPCWsAddress = "127.0.0.1:8546"
 
+	// DefaultJSONRPCAuthJWTSecret is the default path of the JWT secret of the authenticated
+	// JSON-RPC server, relative to the node home directory.
+	DefaultJSONRPCAuthJWTSecret = "config/jwt.hex"
+
 	// DefaultJsonRPCMetricsAddress is the default address the JSON-RPC Metrics server binds to.
 	DefaultJSONRPCMetricsAddress = "127.0.0.1:6065"
 
@@ -48,6 +54,9 @@ const (
 
 	DefaultMaxTxGasWanted = 0
 
+	// DefaultMaxTraceWorkers is the default max number of txs traced in parallel, 0 uses the number of CPUs
+	DefaultMaxTraceWorkers = 0
+
 	DefaultGasCap uint64 = 25000000
 
 	DefaultFilterCap int32 = 200
@@ -58,6 +67,10 @@ const (
 
 	DefaultBlockRangeCap int32 = 10000
 
+	DefaultTraceBlockChunkSize int32 = 100
+
+	DefaultBlockCacheSize = 256
+
 	DefaultEVMTimeout = 5 * time.Second
 	// default 1.0 eth
 	DefaultTxFeeCap float64 = 1.0
@@ -69,6 +82,12 @@ const (
 	DefaultAllowUnprotectedTxs = false
 	// DefaultMaxOpenConnections represents the amount of open connections (unlimited = 0)
 	DefaultMaxOpenConnections = 0
+
+	// DefaultRateLimitRequestsPerSecond is the default rate at which the request budget of a client is refilled
+	DefaultRateLimitRequestsPerSecond float64 = 50
+
+	// DefaultRateLimitBurst is the default max request budget of a client
+	DefaultRateLimitBurst = 100
 )
 
 var evmTracers = []string{"json", "markdown", "struct", "access_list"}
@@ -90,6 +109,9 @@ type EVMConfig struct {
 	Tracer string `mapstructure:"tracer"`
 	// MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 	MaxTxGasWanted uint64 `mapstructure:"max-tx-gas-wanted"`
+	// MaxTraceWorkers defines the max number of txs traced in parallel by the node for the
+	// block traces with the parallel option, 0 uses the number of CPUs.
+	MaxTraceWorkers int `mapstructure:"max-trace-workers"`
 }
 
 // JSONRPCConfig defines configuration for the EVM RPC server.
@@ -100,6 +122,13 @@ type JSONRPCConfig struct {
 	Address string `mapstructure:"address"`
 	// WsAddress defines the WebSocket server to listen on
 	WsAddress string `mapstructure:"ws-address"`
+	// AuthAddress defines the JWT authenticated HTTP server to listen on, the private namespaces
+	// (personal, miner and debug) are only served there if it's set. Its requests are filtered by
+	// the allowed and denied methods but not rate limited.
+	AuthAddress string `mapstructure:"auth-address"`
+	// AuthJWTSecret defines the path of the file holding the hex encoded secret of the JWTs,
+	// relative to the node home directory if not absolute.
+	AuthJWTSecret string `mapstructure:"auth-jwt-secret"`
 	// GasCap is the global gas cap for eth-call variants.
 	GasCap uint64 `mapstructure:"gas-cap"`
 	// EVMTimeout is the global timeout for eth-call.
@@ -116,6 +145,13 @@ type JSONRPCConfig struct {
 	LogsCap int32 `mapstructure:"logs-cap"`
 	// BlockRangeCap defines the max block range allowed for `eth_getLogs` query.
 	BlockRangeCap int32 `mapstructure:"block-range-cap"`
+	// TraceBlockChunkSize defines the max number of transactions traced by a single query
+	// when tracing a block, 0 traces the whole block in one query. It only applies if the
+	// gRPC server is disabled, the block is streamed by a single query otherwise.
+	TraceBlockChunkSize int32 `mapstructure:"trace-block-chunk-size"`
+	// BlockCacheSize defines the max number of entries of each cache of the committed blocks,
+	// block results and receipts queries, 0 disables the caches.
+	BlockCacheSize int `mapstructure:"block-cache-size"`
 	// HTTPTimeout is the read/write timeout of http json-rpc server.
 	HTTPTimeout time.Duration `mapstructure:"http-timeout"`
 	// HTTPIdleTimeout is the idle timeout of http json-rpc server.
@@ -128,8 +164,36 @@ type JSONRPCConfig struct {
 	MaxOpenConnections int `mapstructure:"max-open-connections"`
 	// EnableIndexer defines if enable the custom indexer service.
 	EnableIndexer bool `mapstructure:"enable-indexer"`
+	// IndexInternalTxs defines if the custom indexer traces the blocks to index the txs
+	// under the addresses of their internal transactions.
+	IndexInternalTxs bool `mapstructure:"index-internal-txs"`
 	// MetricsAddress defines the metrics server to listen on
 	MetricsAddress string `mapstructure:"metrics-address"`
+	// MethodsAllow defines the glob patterns of the only JSON-RPC methods served, all the
+	// methods of the enabled namespaces are served if it's empty.
+	MethodsAllow []string `mapstructure:"methods-allow"`
+	// MethodsDeny defines the glob patterns of the JSON-RPC methods never served, it takes
+	// precedence over MethodsAllow.
+	MethodsDeny []string `mapstructure:"methods-deny"`
+	// RateLimit defines the per client rate limiting of the JSON-RPC requests.
+	RateLimit RateLimitConfig `mapstructure:"rate-limit"`
+}
+
+// RateLimitConfig defines the per client rate limiting of the JSON-RPC server, every client IP
+// has a token bucket which is consumed by the weight of the requested methods.
+type RateLimitConfig struct {
+	// Enable defines if the JSON-RPC requests are rate limited.
+	Enable bool `mapstructure:"enable"`
+	// RequestsPerSecond is the number of tokens refilled per second in the bucket of a client.
+	RequestsPerSecond float64 `mapstructure:"requests-per-second"`
+	// Burst is the capacity of the bucket of a client.
+	Burst int `mapstructure:"burst"`
+	// MethodWeights defines the number of tokens consumed by a call of the method, the
+	// methods are matched case-insensitively and the ones not listed consume 1 token.
+	MethodWeights map[string]int `mapstructure:"method-weights"`
+	// TrustedProxies defines the IPs or CIDR networks of the reverse proxies in front of the
+	// server, the client IP of their requests is read from the X-Forwarded-For header.
+	TrustedProxies []string `mapstructure:"trusted-proxies"`
 }
 
 // TLSConfig defines the certificate and matching private key for the server.
@@ -188,8 +252,9 @@ func DefaultConfig() *Config {
 // DefaultEVMConfig returns the default EVM configuration
 func DefaultEVMConfig() *EVMConfig {
 	return &EVMConfig{
-		Tracer:         DefaultEVMTracer,
-		MaxTxGasWanted: DefaultMaxTxGasWanted,
+		Tracer:          DefaultEVMTracer,
+		MaxTxGasWanted:  DefaultMaxTxGasWanted,
+		MaxTraceWorkers: DefaultMaxTraceWorkers,
 	}
 }
 
@@ -199,6 +264,10 @@ func (c EVMConfig) Validate() error {
 		return fmt.Errorf("invalid tracer type %s, available types: %v", c.Tracer, evmTracers)
 	}
 
+	if c.MaxTraceWorkers < 0 {
+		return errors.New("max trace workers cannot be negative")
+	}
+
 	return nil
 }
 
@@ -209,7 +278,7 @@ func GetDefaultAPINamespaces() []string {
 
 // GetAPINamespaces returns the all the available JSON-RPC API namespaces.
 func GetAPINamespaces() []string {
-	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner"}
+	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner", "trace", "ots"}
 }
 
 // DefaultJSONRPCConfig returns an EVM config with the JSON-RPC API enabled by default
@@ -219,6 +288,8 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		API:                 GetDefaultAPINamespaces(),
 		Address:             DefaultJSONRPCAddress,
 		WsAddress:           DefaultJSONRPCWsAddress,
+		AuthAddress:         "",
+		AuthJWTSecret:       DefaultJSONRPCAuthJWTSecret,
 		GasCap:              DefaultGasCap,
 		EVMTimeout:          DefaultEVMTimeout,
 		TxFeeCap:            DefaultTxFeeCap,
@@ -226,21 +297,95 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		FeeHistoryCap:       DefaultFeeHistoryCap,
 		BlockRangeCap:       DefaultBlockRangeCap,
 		LogsCap:             DefaultLogsCap,
+		TraceBlockChunkSize: DefaultTraceBlockChunkSize,
+		BlockCacheSize:      DefaultBlockCacheSize,
 		HTTPTimeout:         DefaultHTTPTimeout,
 		HTTPIdleTimeout:     DefaultHTTPIdleTimeout,
 		AllowUnprotectedTxs: DefaultAllowUnprotectedTxs,
 		MaxOpenConnections:  DefaultMaxOpenConnections,
 		EnableIndexer:       false,
+		IndexInternalTxs:    false,
 		MetricsAddress:      DefaultJSONRPCMetricsAddress,
+		MethodsAllow:        []string{},
+		MethodsDeny:         []string{},
+		RateLimit:           *DefaultRateLimitConfig(),
+	}
+}
+
+// DefaultRateLimitConfig returns the default rate limit configuration, which is disabled and
+// weighs the queries re-executing or scanning the chain over the plain state reads.
+func DefaultRateLimitConfig() *RateLimitConfig {
+	return &RateLimitConfig{
+		Enable:            false,
+		RequestsPerSecond: DefaultRateLimitRequestsPerSecond,
+		Burst:             DefaultRateLimitBurst,
+		MethodWeights: map[string]int{
+			"eth_call":                 5,
+			"eth_estimateGas":          5,
+			"eth_getLogs":              10,
+			"debug_traceTransaction":   20,
+			"debug_traceCall":          20,
+			"debug_traceBlockByNumber": 50,
+			"debug_traceBlockByHash":   50,
+		},
 	}
 }
 
+// Validate returns an error if the rate limit configuration fields are invalid.
+func (c RateLimitConfig) Validate() error {
+	if !c.Enable {
+		return nil
+	}
+
+	if c.RequestsPerSecond <= 0 {
+		return errors.New("rate limit requests per second must be positive")
+	}
+
+	if c.Burst <= 0 {
+		return errors.New("rate limit burst must be positive")
+	}
+
+	for method, weight := range c.MethodWeights {
+		if weight <= 0 || weight > c.Burst {
+			return fmt.Errorf("rate limit weight of method '%s' must be between 1 and the burst %d", method, c.Burst)
+		}
+	}
+
+	for _, proxy := range c.TrustedProxies {
+		if _, err := ParseNetwork(proxy); err != nil {
+			return fmt.Errorf("invalid rate limit trusted proxy: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// ParseNetwork parses an IP or a CIDR network, an IP is the network of the single address.
+func ParseNetwork(s string) (*net.IPNet, error) {
+	if ip := net.ParseIP(s); ip != nil {
+		bits := 8 * net.IPv6len
+		if ip4 := ip.To4(); ip4 != nil {
+			ip, bits = ip4, 8*net.IPv4len
+		}
+		return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, nil
+	}
+	_, network, err := net.ParseCIDR(s)
+	if err != nil {
+		return nil, fmt.Errorf("'%s' is neither an IP nor a CIDR network", s)
+	}
+	return network, nil
+}
+
 // Validate returns an error if the JSON-RPC configuration fields are invalid.
 func (c JSONRPCConfig) Validate() error {
 	if c.Enable && len(c.API) == 0 {
 		return errors.New("cannot enable JSON-RPC without defining any API namespace")
 	}
 
+	if c.AuthAddress != "" && c.AuthJWTSecret == "" {
+		return errors.New("JSON-RPC auth-jwt-secret cannot be empty when auth-address is set")
+	}
+
 	if c.FilterCap < 0 {
 		return errors.New("JSON-RPC filter-cap cannot be negative")
 	}
@@ -265,6 +410,14 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC block range cap cannot be negative")
 	}
 
+	if c.TraceBlockChunkSize < 0 {
+		return errors.New("JSON-RPC trace block chunk size cannot be negative")
+	}
+
+	if c.BlockCacheSize < 0 {
+		return errors.New("JSON-RPC block cache size cannot be negative")
+	}
+
 	if c.HTTPTimeout < 0 {
 		return errors.New("JSON-RPC HTTP timeout duration cannot be negative")
 	}
@@ -273,6 +426,16 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC HTTP idle timeout duration cannot be negative")
 	}
 
+	for _, pattern := range append(append([]string{}, c.MethodsAllow...), c.MethodsDeny...) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid JSON-RPC method pattern '%s': %w", pattern, err)
+		}
+	}
+
+	if err := c.RateLimit.Validate(); err != nil {
+		return err
+	}
+
 	// check for duplicates
 	seenAPIs := make(map[string]bool)
 	for _, api := range c.API {
@@ -321,26 +484,41 @@ func GetConfig(v *viper.Viper) (Config, error) {
 	return Config{
 		Config: cfg,
 		EVM: EVMConfig{
-			Tracer:         v.GetString("evm.tracer"),
-			MaxTxGasWanted: v.GetUint64("evm.max-tx-gas-wanted"),
+			Tracer:          v.GetString("evm.tracer"),
+			MaxTxGasWanted:  v.GetUint64("evm.max-tx-gas-wanted"),
+			MaxTraceWorkers: v.GetInt("evm.max-trace-workers"),
 		},
 		JSONRPC: JSONRPCConfig{
-			Enable:             v.GetBool("json-rpc.enable"),
-			API:                v.GetStringSlice("json-rpc.api"),
-			Address:            v.GetString("json-rpc.address"),
-			WsAddress:          v.GetString("json-rpc.ws-address"),
-			GasCap:             v.GetUint64("json-rpc.gas-cap"),
-			FilterCap:          v.GetInt32("json-rpc.filter-cap"),
-			FeeHistoryCap:      v.GetInt32("json-rpc.feehistory-cap"),
-			TxFeeCap:           v.GetFloat64("json-rpc.txfee-cap"),
-			EVMTimeout:         v.GetDuration("json-rpc.evm-timeout"),
-			LogsCap:            v.GetInt32("json-rpc.logs-cap"),
-			BlockRangeCap:      v.GetInt32("json-rpc.block-range-cap"),
-			HTTPTimeout:        v.GetDuration("json-rpc.http-timeout"),
-			HTTPIdleTimeout:    v.GetDuration("json-rpc.http-idle-timeout"),
-			MaxOpenConnections: v.GetInt("json-rpc.max-open-connections"),
-			EnableIndexer:      v.GetBool("json-rpc.enable-indexer"),
-			MetricsAddress:     v.GetString("json-rpc.metrics-address"),
+			Enable:              v.GetBool("json-rpc.enable"),
+			API:                 v.GetStringSlice("json-rpc.api"),
+			Address:             v.GetString("json-rpc.address"),
+			WsAddress:           v.GetString("json-rpc.ws-address"),
+			AuthAddress:         v.GetString("json-rpc.auth-address"),
+			AuthJWTSecret:       v.GetString("json-rpc.auth-jwt-secret"),
+			GasCap:              v.GetUint64("json-rpc.gas-cap"),
+			FilterCap:           v.GetInt32("json-rpc.filter-cap"),
+			FeeHistoryCap:       v.GetInt32("json-rpc.feehistory-cap"),
+			TxFeeCap:            v.GetFloat64("json-rpc.txfee-cap"),
+			EVMTimeout:          v.GetDuration("json-rpc.evm-timeout"),
+			LogsCap:             v.GetInt32("json-rpc.logs-cap"),
+			BlockRangeCap:       v.GetInt32("json-rpc.block-range-cap"),
+			TraceBlockChunkSize: v.GetInt32("json-rpc.trace-block-chunk-size"),
+			BlockCacheSize:      v.GetInt("json-rpc.block-cache-size"),
+			HTTPTimeout:         v.GetDuration("json-rpc.http-timeout"),
+			HTTPIdleTimeout:     v.GetDuration("json-rpc.http-idle-timeout"),
+			MaxOpenConnections:  v.GetInt("json-rpc.max-open-connections"),
+			EnableIndexer:       v.GetBool("json-rpc.enable-indexer"),
+			IndexInternalTxs:    v.GetBool("json-rpc.index-internal-txs"),
+			MetricsAddress:      v.GetString("json-rpc.metrics-address"),
+			MethodsAllow:        v.GetStringSlice("json-rpc.methods-allow"),
+			MethodsDeny:         v.GetStringSlice("json-rpc.methods-deny"),
+			RateLimit: RateLimitConfig{
+				Enable:            v.GetBool("json-rpc.rate-limit.enable"),
+				RequestsPerSecond: v.GetFloat64("json-rpc.rate-limit.requests-per-second"),
+				Burst:             v.GetInt("json-rpc.rate-limit.burst"),
+				MethodWeights:     cast.ToStringMapInt(v.Get("json-rpc.rate-limit.method-weights")),
+				TrustedProxies:    v.GetStringSlice("json-rpc.rate-limit.trusted-proxies"),
+			},
 		},
 		TLS: TLSConfig{
 			CertificatePath: v.GetString("tls.certificate-path"),
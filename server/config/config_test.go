@@ -12,3 +12,51 @@ func TestDefaultConfig(t *testing.T) {
 	require.Equal(t, cfg.JSONRPC.Address, DefaultJSONRPCAddress)
 	require.Equal(t, cfg.JSONRPC.WsAddress, DefaultJSONRPCWsAddress)
 }
+
+func TestRateLimitConfigValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		config  RateLimitConfig
+		expPass bool
+	}{
+		{"disabled", RateLimitConfig{}, true},
+		{"default", *DefaultRateLimitConfig(), true},
+		{"enabled", RateLimitConfig{Enable: true, RequestsPerSecond: 1, Burst: 1}, true},
+		{"zero rate", RateLimitConfig{Enable: true, Burst: 1}, false},
+		{"zero burst", RateLimitConfig{Enable: true, RequestsPerSecond: 1}, false},
+		{"weight above burst", RateLimitConfig{Enable: true, RequestsPerSecond: 1, Burst: 5, MethodWeights: map[string]int{"eth_getLogs": 6}}, false},
+		{"zero weight", RateLimitConfig{Enable: true, RequestsPerSecond: 1, Burst: 5, MethodWeights: map[string]int{"eth_getLogs": 0}}, false},
+		{"trusted proxies", RateLimitConfig{Enable: true, RequestsPerSecond: 1, Burst: 1, TrustedProxies: []string{"10.0.0.0/8", "::1"}}, true},
+		{"invalid trusted proxy", RateLimitConfig{Enable: true, RequestsPerSecond: 1, Burst: 1, TrustedProxies: []string{"proxy"}}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestJSONRPCConfigValidateMethodPatterns(t *testing.T) {
+	cfg := DefaultJSONRPCConfig()
+	cfg.MethodsAllow = []string{"eth_*", "debug_trace*"}
+	cfg.MethodsDeny = []string{"debug_set*"}
+	require.NoError(t, cfg.Validate())
+
+	cfg.MethodsDeny = []string{"debug_[set"}
+	require.Error(t, cfg.Validate())
+}
+
+func TestJSONRPCConfigValidateAuth(t *testing.T) {
+	cfg := DefaultJSONRPCConfig()
+	cfg.AuthAddress = "127.0.0.1:8551"
+	require.NoError(t, cfg.Validate())
+
+	cfg.AuthJWTSecret = ""
+	require.Error(t, cfg.Validate())
+}
@@ -31,6 +31,10 @@ tracer = "{{ .EVM.Tracer }}"
 # MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 max-tx-gas-wanted = {{ .EVM.MaxTxGasWanted }}
 
+# MaxTraceWorkers defines the max number of txs traced in parallel by the node, shared by all the
+# block traces with the parallel option. 0 uses the number of CPUs.
+max-trace-workers = {{ .EVM.MaxTraceWorkers }}
+
 ###############################################################################
 ###                           JSON RPC Configuration                        ###
 ###############################################################################
@@ -46,6 +50,16 @@ address = "{{ .JSONRPC.Address }}"
 # Address defines the EVM WebSocket server address to bind to.
 ws-address = "{{ .JSONRPC.WsAddress }}"
 
+# AuthAddress defines the EVM RPC HTTP server address to bind to for the requests authenticated with
+# a HS256 JWT, like the go-ethereum Engine API. If set, the private namespaces (personal, miner and
+# debug) are only served there, along with the other enabled namespaces. Empty disables the server.
+# Its requests are filtered by methods-allow and methods-deny, but they are not rate limited.
+auth-address = "{{ .JSONRPC.AuthAddress }}"
+
+# AuthJWTSecret defines the path of the file holding the hex encoded 32 bytes secret of the JWTs,
+# relative to the node home directory if not absolute. The secret is generated if the file doesn't exist.
+auth-jwt-secret = "{{ .JSONRPC.AuthJWTSecret }}"
+
 # API defines a list of JSON-RPC namespaces that should be enabled
 # Example: "eth,txpool,personal,net,debug,web3"
 api = "{{range $index, $elmt := .JSONRPC.API}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
@@ -71,6 +85,15 @@ logs-cap = {{ .JSONRPC.LogsCap }}
 # BlockRangeCap defines the max block range allowed for 'eth_getLogs' query.
 block-range-cap = {{ .JSONRPC.BlockRangeCap }}
 
+# TraceBlockChunkSize defines the max number of transactions traced by a single query when tracing
+# a block with 'debug_traceBlockByNumber' or 'debug_traceBlockByHash', 0 traces the whole block at once.
+# It only applies if the gRPC server is disabled, the block is streamed by a single query otherwise.
+trace-block-chunk-size = {{ .JSONRPC.TraceBlockChunkSize }}
+
+# BlockCacheSize defines the max number of entries of each cache of the committed blocks, block results,
+# ethereum blocks and receipts queries, 0 disables the caches. The latest and pending queries are not cached.
+block-cache-size = {{ .JSONRPC.BlockCacheSize }}
+
 # HTTPTimeout is the read/write timeout of http json-rpc server.
 http-timeout = "{{ .JSONRPC.HTTPTimeout }}"
 
@@ -88,10 +111,50 @@ max-open-connections = {{ .JSONRPC.MaxOpenConnections }}
 # EnableIndexer enables the custom transaction indexer for the EVM (ethereum transactions).
 enable-indexer = {{ .JSONRPC.EnableIndexer }}
 
+# IndexInternalTxs makes the custom indexer trace every block to index the transactions under the
+# addresses taking part in their internal transactions, eg. the contracts created by a factory.
+# It's used by the ots namespace and requires the state of the indexed blocks.
+index-internal-txs = {{ .JSONRPC.IndexInternalTxs }}
+
 # MetricsAddress defines the EVM Metrics server address to bind to. Pass --metrics in CLI to enable
 # Prometheus metrics path: /debug/metrics/prometheus
 metrics-address = "{{ .JSONRPC.MetricsAddress }}"
 
+# MethodsAllow defines the glob patterns of the only JSON-RPC methods served, all the methods of
+# the enabled namespaces are served if it's empty.
+# Example: ["eth_*", "net_*", "web3_*", "debug_trace*"]
+methods-allow = [{{range $index, $elmt := .JSONRPC.MethodsAllow}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+
+# MethodsDeny defines the glob patterns of the JSON-RPC methods never served, it takes precedence
+# over methods-allow. The denied methods are reported as not found.
+# Example: ["debug_write*", "debug_start*", "debug_stop*", "debug_set*"]
+methods-deny = [{{range $index, $elmt := .JSONRPC.MethodsDeny}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+
+[json-rpc.rate-limit]
+
+# Enable defines if the JSON-RPC requests are rate limited per client IP, the throttled
+# requests get a -32005 'limit exceeded' error. The requests of the auth-address server
+# are not rate limited.
+enable = {{ .JSONRPC.RateLimit.Enable }}
+
+# RequestsPerSecond is the number of tokens refilled per second in the bucket of a client.
+requests-per-second = {{ .JSONRPC.RateLimit.RequestsPerSecond }}
+
+# Burst is the capacity of the bucket of a client, it bounds the weight of a single batch request.
+# The batch requests weighing more are rejected with a -32600 error instead.
+burst = {{ .JSONRPC.RateLimit.Burst }}
+
+# TrustedProxies defines the IPs or CIDR networks of the reverse proxies in front of the server.
+# The client IP of their requests is read from the X-Forwarded-For header, otherwise the requests
+# are limited per connection address. Only list the proxies overwriting or appending to the header.
+# Example: ["10.0.0.0/8", "127.0.0.1"]
+trusted-proxies = [{{range $index, $elmt := .JSONRPC.RateLimit.TrustedProxies}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+
+# MethodWeights defines the number of tokens consumed by a call of the method, the methods
+# not listed consume 1 token. The elements of a batch request are weighed individually.
+[json-rpc.rate-limit.method-weights]
+{{range $method, $weight := .JSONRPC.RateLimit.MethodWeights}}{{$method}} = {{$weight}}
+{{end}}
 ###############################################################################
 ###                             TLS Configuration                           ###
 ###############################################################################
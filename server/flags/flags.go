@@ -48,17 +48,28 @@ const (
 	JSONRPCAPI                 = "json-rpc.api"
 	JSONRPCAddress             = "json-rpc.address"
 	JSONWsAddress              = "json-rpc.ws-address"
+	JSONRPCAuthAddress         = "json-rpc.auth-address"
+	JSONRPCAuthJWTSecret       = "json-rpc.auth-jwt-secret"
 	JSONRPCGasCap              = "json-rpc.gas-cap"
 	JSONRPCEVMTimeout          = "json-rpc.evm-timeout"
 	JSONRPCTxFeeCap            = "json-rpc.txfee-cap"
 	JSONRPCFilterCap           = "json-rpc.filter-cap"
 	JSONRPCLogsCap             = "json-rpc.logs-cap"
 	JSONRPCBlockRangeCap       = "json-rpc.block-range-cap"
+	JSONRPCTraceBlockChunkSize = "json-rpc.trace-block-chunk-size"
+	JSONRPCBlockCacheSize      = "json-rpc.block-cache-size"
 	JSONRPCHTTPTimeout         = "json-rpc.http-timeout"
 	JSONRPCHTTPIdleTimeout     = "json-rpc.http-idle-timeout"
 	JSONRPCAllowUnprotectedTxs = "json-rpc.allow-unprotected-txs"
 	JSONRPCMaxOpenConnections  = "json-rpc.max-open-connections"
 	JSONRPCEnableIndexer       = "json-rpc.enable-indexer"
+	JSONRPCIndexInternalTxs    = "json-rpc.index-internal-txs"
+	JSONRPCMethodsAllow        = "json-rpc.methods-allow"
+	JSONRPCMethodsDeny         = "json-rpc.methods-deny"
+	JSONRPCRateLimitEnable     = "json-rpc.rate-limit.enable"
+	JSONRPCRateLimitRPS        = "json-rpc.rate-limit.requests-per-second"
+	JSONRPCRateLimitBurst      = "json-rpc.rate-limit.burst"
+	JSONRPCRateLimitProxies    = "json-rpc.rate-limit.trusted-proxies"
 	// JSONRPCEnableMetrics enables EVM RPC metrics server.
 	// Set to `metrics` which is hardcoded flag from go-ethereum.
 	// https://github.com/ethereum/go-ethereum/blob/master/metrics/metrics.go#L35-L55
@@ -67,8 +78,9 @@ const (
 
 // EVM flags
 const (
-	EVMTracer         = "evm.tracer"
-	EVMMaxTxGasWanted = "evm.max-tx-gas-wanted"
+	EVMTracer          = "evm.tracer"
+	EVMMaxTxGasWanted  = "evm.max-tx-gas-wanted"
+	EVMMaxTraceWorkers = "evm.max-trace-workers"
 )
 
 // TLS flags
@@ -38,6 +38,9 @@ func NewIndexTxCmd() *cobra.Command {
 
 		When start the node, the indexer start from the latest indexed block to avoid creating gap.
         Backward mode should be used most of the time, so the latest indexed block is always up-to-date.
+
+		The logs of the blocks are indexed by address and first topic along with the txs, the log index is used by eth_getLogs
+		when the queried block range is fully indexed, run backward mode to backfill it on an existing indexer db.
 		`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -95,7 +98,8 @@ func NewIndexTxCmd() *cobra.Command {
 
 			switch args[0] {
 			case "backward":
-				first, err := idxer.FirstIndexedBlock()
+				// the log index covers every indexed block, including the ones without eth txs
+				first, _, err := idxer.LogIndexedRange()
 				if err != nil {
 					return err
 				}
@@ -109,10 +113,17 @@ func NewIndexTxCmd() *cobra.Command {
 					}
 				}
 			case "forward":
-				latest, err := idxer.LastIndexedBlock()
+				_, latest, err := idxer.LogIndexedRange()
 				if err != nil {
 					return err
 				}
+				if latest == -1 {
+					// the log index is empty if the indexer db predates it
+					latest, err = idxer.LastIndexedBlock()
+					if err != nil {
+						return err
+					}
+				}
 				if latest == -1 {
 					// start from genesis if empty
 					latest = 0
@@ -25,6 +25,7 @@ import (
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
 	coretypes "github.com/cometbft/cometbft/rpc/core/types"
 	"github.com/cometbft/cometbft/types"
+	"github.com/ethereum/go-ethereum/common"
 
 	ethermint "github.com/evmos/ethermint/types"
 )
@@ -38,20 +39,30 @@ const (
 	statusClientTimeout          = time.Hour * 48
 )
 
+// InternalTxTracer traces the eth txs of a block and returns, by tx hash, the addresses
+// taking part in their internal transactions.
+type InternalTxTracer interface {
+	InternalTxAddresses(height int64) (map[common.Hash][]common.Address, error)
+}
+
 // EVMIndexerService indexes transactions for json-rpc service.
 type EVMIndexerService struct {
 	service.BaseService
 
 	txIdxr ethermint.EVMTxIndexer
 	client rpcclient.Client
+	// internalTxTracer is nil if the internal txs aren't indexed
+	internalTxTracer InternalTxTracer
 }
 
-// NewEVMIndexerService returns a new service instance.
+// NewEVMIndexerService returns a new service instance, the internal txs of the blocks
+// are indexed too if the internal tx tracer is not nil.
 func NewEVMIndexerService(
 	txIdxr ethermint.EVMTxIndexer,
 	client rpcclient.Client,
+	internalTxTracer InternalTxTracer,
 ) *EVMIndexerService {
-	is := &EVMIndexerService{txIdxr: txIdxr, client: client}
+	is := &EVMIndexerService{txIdxr: txIdxr, client: client, internalTxTracer: internalTxTracer}
 	is.BaseService = *service.NewBaseService(nil, ServiceName, is)
 	return is
 }
@@ -143,12 +154,32 @@ func (eis *EVMIndexerService) OnStart() error {
 			}
 			if err := eis.txIdxr.IndexBlock(block.Block, blockResult.TxsResults); err != nil {
 				eis.Logger.Error("failed to index block", "height", i, "err", err)
+			} else if err := eis.indexInternalTxs(i); err != nil {
+				eis.Logger.Error("failed to index internal txs", "height", i, "err", err)
 			}
 			lastBlock = blockResult.Height
 		}
 	}
 }
 
+// indexInternalTxs indexes the txs of the block under the addresses of their internal
+// txs if the internal tx tracer is set.
+func (eis *EVMIndexerService) indexInternalTxs(height int64) error {
+	if eis.internalTxTracer == nil {
+		return nil
+	}
+	addressIndexer, ok := eis.txIdxr.(ethermint.EVMAddressIndexer)
+	if !ok {
+		return nil
+	}
+
+	addresses, err := eis.internalTxTracer.InternalTxAddresses(height)
+	if err != nil {
+		return err
+	}
+	return addressIndexer.IndexInternalTxs(addresses)
+}
+
 // waitUntilClientReady waits until StatusClient is ready to serve requests
 func waitUntilClientReady(ctx context.Context, client rpcclient.StatusClient, b backoff.BackOff) error {
 	err := backoff.Retry(func() error {
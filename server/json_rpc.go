@@ -17,6 +17,7 @@ package server
 
 import (
 	"net/http"
+	"path/filepath"
 	"time"
 
 	"github.com/gorilla/mux"
@@ -26,8 +27,14 @@ import (
 	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/server/types"
 	ethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/metrics"
 	ethrpc "github.com/ethereum/go-ethereum/rpc"
 	"github.com/evmos/ethermint/rpc"
+	"github.com/evmos/ethermint/rpc/auth"
+	"github.com/evmos/ethermint/rpc/backend"
+	"github.com/evmos/ethermint/rpc/methodfilter"
+	"github.com/evmos/ethermint/rpc/ratelimit"
+	"github.com/evmos/ethermint/rpc/rpcmetrics"
 
 	"github.com/evmos/ethermint/server/config"
 	ethermint "github.com/evmos/ethermint/types"
@@ -58,24 +65,67 @@ func StartJSONRPC(ctx *server.Context,
 
 	rpcServer := ethrpc.NewServer()
 
+	// the authenticated server serves all the namespaces, the private ones are then not served
+	// by the public server
+	var authServer *ethrpc.Server
+	if config.JSONRPC.AuthAddress != "" {
+		authServer = ethrpc.NewServer()
+	}
+
 	allowUnprotectedTxs := config.JSONRPC.AllowUnprotectedTxs
 	rpcAPIArr := config.JSONRPC.API
 
-	apis := rpc.GetRPCAPIs(ctx, clientCtx, tmWsClient, allowUnprotectedTxs, indexer, rpcAPIArr)
+	// the committed blocks are cached once for all the namespaces and the websocket server
+	blockCache := backend.NewBlockCache(config.JSONRPC.BlockCacheSize)
+	apis := rpc.GetRPCAPIs(ctx, clientCtx, tmWsClient, allowUnprotectedTxs, indexer, blockCache, rpcAPIArr)
 
 	for _, api := range apis {
-		if err := rpcServer.RegisterName(api.Namespace, api.Service); err != nil {
-			ctx.Logger.Error(
-				"failed to register service in JSON RPC namespace",
-				"namespace", api.Namespace,
-				"service", api.Service,
-			)
-			return nil, nil, err
+		servers := []*ethrpc.Server{rpcServer}
+		if authServer != nil {
+			servers = []*ethrpc.Server{authServer}
+			if api.Public {
+				servers = append(servers, rpcServer)
+			}
+		}
+
+		for _, srv := range servers {
+			if err := srv.RegisterName(api.Namespace, api.Service); err != nil {
+				ctx.Logger.Error(
+					"failed to register service in JSON RPC namespace",
+					"namespace", api.Namespace,
+					"service", api.Service,
+				)
+				return nil, nil, err
+			}
 		}
 	}
 
+	var filter *methodfilter.Filter
+	if len(config.JSONRPC.MethodsAllow) > 0 || len(config.JSONRPC.MethodsDeny) > 0 {
+		filter = methodfilter.NewFilter(config.JSONRPC.MethodsAllow, config.JSONRPC.MethodsDeny)
+	}
+	var limiter *ratelimit.Limiter
+	if config.JSONRPC.RateLimit.Enable {
+		limiter = ratelimit.NewLimiter(config.JSONRPC.RateLimit)
+	}
+
+	var rpcMetrics *rpcmetrics.Metrics
+	if metrics.Enabled {
+		rpcMetrics = rpcmetrics.New(apis)
+	}
+
+	// the metrics are the outermost handler to record the filtered and throttled requests too
+	var handler http.Handler = rpcServer
+	if filter != nil {
+		handler = filter.Handler(handler)
+	}
+	if limiter != nil {
+		handler = limiter.Handler(handler)
+	}
+	handler = rpcMetrics.Handler(handler)
+
 	r := mux.NewRouter()
-	r.HandleFunc("/", rpcServer.ServeHTTP).Methods("POST")
+	r.Handle("/", handler).Methods("POST")
 
 	handlerWithCors := cors.Default()
 	if config.API.EnableUnsafeCORS {
@@ -118,11 +168,65 @@ func StartJSONRPC(ctx *server.Context,
 	case <-time.After(types.ServerStartTime): // assume JSON RPC server started successfully
 	}
 
+	if authServer != nil {
+		// the authenticated requests are filtered the same but not rate limited
+		var authHandler http.Handler = authServer
+		if filter != nil {
+			authHandler = filter.Handler(authHandler)
+		}
+		if err := startAuthJSONRPC(ctx, config, rpcMetrics.Handler(authHandler), httpSrv); err != nil {
+			ctx.Logger.Error("failed to boot authenticated JSON-RPC server", "error", err.Error())
+			return nil, nil, err
+		}
+	}
+
 	ctx.Logger.Info("Starting JSON WebSocket server", "address", config.JSONRPC.WsAddress)
 
 	// allocate separate WS connection to Tendermint
 	tmWsClient = ConnectTmWS(tmRPCAddr, tmEndpoint, ctx.Logger)
-	wsSrv := rpc.NewWebsocketsServer(clientCtx, ctx.Logger, tmWsClient, config)
+	evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer, blockCache)
+	wsSrv := rpc.NewWebsocketsServer(clientCtx, ctx.Logger, tmWsClient, evmBackend, filter, limiter, rpcMetrics, config)
 	wsSrv.Start()
 	return httpSrv, httpSrvDone, nil
 }
+
+// startAuthJSONRPC starts the JWT authenticated JSON-RPC server, which is closed when the public
+// JSON-RPC server is shut down.
+func startAuthJSONRPC(ctx *server.Context, config *config.Config, rpcHandler http.Handler, httpSrv *http.Server) error {
+	secretPath := config.JSONRPC.AuthJWTSecret
+	if !filepath.IsAbs(secretPath) {
+		secretPath = filepath.Join(ctx.Config.RootDir, secretPath)
+	}
+	secret, err := auth.ObtainJWTSecret(secretPath)
+	if err != nil {
+		return err
+	}
+
+	r := mux.NewRouter()
+	r.Handle("/", auth.Handler(secret, rpcHandler)).Methods("POST")
+
+	authSrv := &http.Server{
+		Addr:              config.JSONRPC.AuthAddress,
+		Handler:           r,
+		ReadHeaderTimeout: config.JSONRPC.HTTPTimeout,
+		ReadTimeout:       config.JSONRPC.HTTPTimeout,
+		WriteTimeout:      config.JSONRPC.HTTPTimeout,
+		IdleTimeout:       config.JSONRPC.HTTPIdleTimeout,
+	}
+
+	ln, err := Listen(authSrv.Addr, config)
+	if err != nil {
+		return err
+	}
+	httpSrv.RegisterOnShutdown(func() {
+		_ = authSrv.Close()
+	})
+
+	go func() {
+		ctx.Logger.Info("Starting authenticated JSON-RPC server", "address", config.JSONRPC.AuthAddress, "jwt-secret", secretPath)
+		if err := authSrv.Serve(ln); err != nil && err != http.ErrServerClosed {
+			ctx.Logger.Error("failed to start authenticated JSON-RPC server", "error", err.Error())
+		}
+	}()
+	return nil
+}
@@ -57,6 +57,7 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 
 	"github.com/evmos/ethermint/indexer"
+	"github.com/evmos/ethermint/rpc/backend"
 	ethdebug "github.com/evmos/ethermint/rpc/namespaces/ethereum/debug"
 	"github.com/evmos/ethermint/server/config"
 	srvflags "github.com/evmos/ethermint/server/flags"
@@ -199,6 +200,8 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().StringSlice(srvflags.JSONRPCAPI, config.GetDefaultAPINamespaces(), "Defines a list of JSON-RPC namespaces that should be enabled")
 	cmd.Flags().String(srvflags.JSONRPCAddress, config.DefaultJSONRPCAddress, "the JSON-RPC server address to listen on")
 	cmd.Flags().String(srvflags.JSONWsAddress, config.DefaultJSONRPCWsAddress, "the JSON-RPC WS server address to listen on")
+	cmd.Flags().String(srvflags.JSONRPCAuthAddress, "", "the JWT authenticated JSON-RPC server address to listen on, serving the private namespaces")
+	cmd.Flags().String(srvflags.JSONRPCAuthJWTSecret, config.DefaultJSONRPCAuthJWTSecret, "the JWT secret file path of the authenticated JSON-RPC server")
 	cmd.Flags().Uint64(srvflags.JSONRPCGasCap, config.DefaultGasCap, "Sets a cap on gas that can be used in eth_call/estimateGas unit is aphoton (0=infinite)")     //nolint:lll
 	cmd.Flags().Float64(srvflags.JSONRPCTxFeeCap, config.DefaultTxFeeCap, "Sets a cap on transaction fee that can be sent via the RPC APIs (1 = default 1 photon)") //nolint:lll
 	cmd.Flags().Int32(srvflags.JSONRPCFilterCap, config.DefaultFilterCap, "Sets the global cap for total number of filters that can be created")
@@ -208,12 +211,22 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Bool(srvflags.JSONRPCAllowUnprotectedTxs, config.DefaultAllowUnprotectedTxs, "Allow for unprotected (non EIP155 signed) transactions to be submitted via the node's RPC when the global parameter is disabled") //nolint:lll
 	cmd.Flags().Int32(srvflags.JSONRPCLogsCap, config.DefaultLogsCap, "Sets the max number of results can be returned from single `eth_getLogs` query")
 	cmd.Flags().Int32(srvflags.JSONRPCBlockRangeCap, config.DefaultBlockRangeCap, "Sets the max block range allowed for `eth_getLogs` query")
+	cmd.Flags().Int32(srvflags.JSONRPCTraceBlockChunkSize, config.DefaultTraceBlockChunkSize, "Sets the max number of txs traced by a single query when tracing a block (0=whole block)")
+	cmd.Flags().Int(srvflags.JSONRPCBlockCacheSize, config.DefaultBlockCacheSize, "Sets the max number of entries of each json-rpc cache of committed blocks (0=disabled)")
 	cmd.Flags().Int(srvflags.JSONRPCMaxOpenConnections, config.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener") //nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
+	cmd.Flags().Bool(srvflags.JSONRPCIndexInternalTxs, false, "Index the txs under the addresses of their internal txs by tracing the blocks (requires the custom tx indexer)")
+	cmd.Flags().StringSlice(srvflags.JSONRPCMethodsAllow, nil, "Defines the glob patterns of the only json-rpc methods served (empty=all)")
+	cmd.Flags().StringSlice(srvflags.JSONRPCMethodsDeny, nil, "Defines the glob patterns of the json-rpc methods never served")
+	cmd.Flags().Bool(srvflags.JSONRPCRateLimitEnable, false, "Define if the json-rpc requests are rate limited per client IP")
+	cmd.Flags().Float64(srvflags.JSONRPCRateLimitRPS, config.DefaultRateLimitRequestsPerSecond, "Sets the rate limit tokens refilled per second for a client")
+	cmd.Flags().Int(srvflags.JSONRPCRateLimitBurst, config.DefaultRateLimitBurst, "Sets the rate limit bucket capacity of a client")
+	cmd.Flags().StringSlice(srvflags.JSONRPCRateLimitProxies, nil, "Defines the IPs or CIDR networks of the reverse proxies whose X-Forwarded-For header is trusted by the rate limiter")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
 
 	cmd.Flags().String(srvflags.EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
 	cmd.Flags().Uint64(srvflags.EVMMaxTxGasWanted, config.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")                                 //nolint:lll
+	cmd.Flags().Int(srvflags.EVMMaxTraceWorkers, config.DefaultMaxTraceWorkers, "the max number of txs traced in parallel by the node (0=number of CPUs)")
 
 	cmd.Flags().String(srvflags.TLSCertPath, "", "the cert.pem file path for the server TLS configuration")
 	cmd.Flags().String(srvflags.TLSKeyPath, "", "the key.pem file path for the server TLS configuration")
@@ -443,7 +456,19 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, opts StartOpt
 
 		idxLogger := ctx.Logger.With("indexer", "evm")
 		idxer = indexer.NewKVIndexer(idxDB, idxLogger, clientCtx)
-		indexerService := NewEVMIndexerService(idxer, clientCtx.Client.(rpcclient.Client))
+
+		var internalTxTracer InternalTxTracer
+		if config.JSONRPC.IndexInternalTxs {
+			genDoc, err := genDocProvider()
+			if err != nil {
+				return err
+			}
+			// the blocks are traced through the evm queries like for the debug namespace
+			internalTxTracer = backend.NewBackend(
+				ctx, idxLogger, clientCtx.WithChainID(genDoc.ChainID), config.JSONRPC.AllowUnprotectedTxs, idxer, nil,
+			)
+		}
+		indexerService := NewEVMIndexerService(idxer, clientCtx.Client.(rpcclient.Client), internalTxTracer)
 		indexerService.SetLogger(idxLogger)
 
 		errCh := make(chan error)
@@ -19,6 +19,7 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmtypes "github.com/cometbft/cometbft/types"
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
 // EVMTxIndexer defines the interface of custom eth tx indexer.
@@ -32,3 +33,31 @@ type EVMTxIndexer interface {
 	// GetByBlockAndIndex returns nil if tx not found.
 	GetByBlockAndIndex(int64, int32) (*TxResult, error)
 }
+
+// EVMLogIndexer defines the interface of custom eth log indexer.
+type EVMLogIndexer interface {
+	// LogIndexedRange returns the first and the latest log-indexed block numbers,
+	// both are -1 if no block is indexed.
+	LogIndexedRange() (int64, int64, error)
+	// LogIndexedUntil returns the last block of the range such that the logs of every
+	// block from the start of the range up to it are indexed, from-1 if none is.
+	LogIndexedUntil(from, to int64) (int64, error)
+	// IsLogIndexed returns true if the logs of every block within the range are indexed.
+	IsLogIndexed(from, to int64) (bool, error)
+	// GetLogs returns the logs within the block range emitted by one of the addresses
+	// and matching the topic rules, empty filters match any log. At most limit+1 logs
+	// are returned.
+	GetLogs(from, to int64, addresses []common.Address, topics [][]common.Hash, limit int) ([]*ethtypes.Log, error)
+}
+
+// EVMAddressIndexer defines the interface of custom eth address tx indexer.
+type EVMAddressIndexer interface {
+	// IndexInternalTxs indexes the txs by hash under the addresses taking part in their
+	// internal transactions.
+	IndexInternalTxs(addresses map[common.Hash][]common.Address) error
+	// GetTxsByAddress returns the hashes of the txs involving the address in the blocks
+	// before the height if reverse, otherwise after the height, the txs of the last block
+	// are all returned even if it exceeds the page size. It returns true if there are
+	// more txs beyond the last block.
+	GetTxsByAddress(address common.Address, height int64, reverse bool, pageSize int) ([]common.Hash, bool, error)
+}
@@ -16,27 +16,38 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
+	"sort"
+	"strconv"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/eth/tracers"
 	"github.com/ethereum/go-ethereum/eth/tracers/logger"
 
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
+	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 	ethparams "github.com/ethereum/go-ethereum/params"
 
 	ethermint "github.com/evmos/ethermint/types"
@@ -48,6 +59,14 @@ var _ types.QueryServer = Keeper{}
 
 const (
 	defaultTraceTimeout = 5 * time.Second
+
+	// accountRangePageSize is the number of accounts read at once from the auth store by
+	// the account range query
+	accountRangePageSize = 256
+
+	// maxTraceCacheDepth is the max number of cache branches stacked by the parallel tracing
+	// of a block before they are written down
+	maxTraceCacheDepth = 16
 )
 
 // Account implements the Query/Account gRPC method
@@ -245,9 +264,12 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	if err := setCallOverrides(cfg, req.Overrides, req.BlockOverrides); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
 	// ApplyMessageWithConfig expect correct nonce set in msg
-	nonce := k.GetNonce(ctx, args.GetFrom())
+	nonce := k.getNonceWithOverride(ctx, args.GetFrom(), cfg.Overrides)
 	args.Nonce = (*hexutil.Uint64)(&nonce)
 
 	msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
@@ -319,9 +341,12 @@ func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*type
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to load evm config")
 	}
+	if err := setCallOverrides(cfg, req.Overrides, req.BlockOverrides); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
 	// ApplyMessageWithConfig expect correct nonce set in msg
-	nonce := k.GetNonce(ctx, args.GetFrom())
+	nonce := k.getNonceWithOverride(ctx, args.GetFrom(), cfg.Overrides)
 	args.Nonce = (*hexutil.Uint64)(&nonce)
 
 	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash().Bytes()))
@@ -390,6 +415,254 @@ func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*type
 	return &types.EstimateGasResponse{Gas: hi}, nil
 }
 
+// SimulateCalls executes the calls of the requested blocks in order, every call sees the
+// state changes of the previous ones. The state is discarded at the end of the query.
+// The first block has the number and the time of the queried block unless it's overridden,
+// `eth_simulateV1` overrides them with the ones of the next block. Each following block is
+// one block and `SimBlockTimeIncrement` seconds later than the previous one by default,
+// overridden numbers and times must increase. The gas cap is the gas budget of all the
+// calls of the query.
+func (k Keeper) SimulateCalls(c context.Context, req *types.QuerySimulateCallsRequest) (*types.QuerySimulateCallsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var blocks []types.SimBlock
+	if err := json.Unmarshal(req.Blocks, &blocks); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if len(blocks) > types.MaxSimulateBlocks {
+		return nil, status.Errorf(codes.InvalidArgument, "too many blocks: %d > %d", len(blocks), types.MaxSimulateBlocks)
+	}
+	calls := 0
+	for _, block := range blocks {
+		calls += len(block.Calls)
+	}
+	if calls > types.MaxSimulateCalls {
+		return nil, status.Errorf(codes.InvalidArgument, "too many calls: %d > %d", calls, types.MaxSimulateCalls)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	chainID, err := getChainID(ctx, req.ChainId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// branch the state so that the calls are never persisted
+	ctx, _ = ctx.CacheContext()
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+
+	// the calls share the gas cap, 0 means no cap like for the other calls
+	gasBudget := req.GasCap
+	number := big.NewInt(ctx.BlockHeight())
+	timestamp := big.NewInt(ctx.BlockHeader().Time.Unix())
+
+	results := make([]types.SimBlockResult, 0, len(blocks))
+	for i, block := range blocks {
+		cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		blockOverrides := types.BlockOverrides{}
+		if block.BlockOverrides != nil {
+			blockOverrides = *block.BlockOverrides
+		}
+		if blockOverrides.Number == nil {
+			if i > 0 {
+				number = new(big.Int).Add(number, common.Big1)
+			}
+			blockOverrides.Number = (*hexutil.Big)(number)
+		} else if i > 0 && blockOverrides.Number.ToInt().Cmp(number) <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "block number %s is not greater than the previous one %s", blockOverrides.Number, (*hexutil.Big)(number))
+		}
+		if blockOverrides.Time == nil {
+			if i > 0 {
+				timestamp = new(big.Int).Add(timestamp, big.NewInt(types.SimBlockTimeIncrement))
+			}
+			blockOverrides.Time = (*hexutil.Big)(timestamp)
+		} else if i > 0 && blockOverrides.Time.ToInt().Cmp(timestamp) <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "block time %s is not greater than the previous one %s", blockOverrides.Time, (*hexutil.Big)(timestamp))
+		}
+		number, timestamp = blockOverrides.Number.ToInt(), blockOverrides.Time.ToInt()
+
+		cfg.BlockOverrides = &blockOverrides
+		if blockOverrides.BaseFee != nil {
+			cfg.BaseFee = blockOverrides.BaseFee.ToInt()
+		}
+
+		// state overrides are persisted in the branched state for the following calls
+		if block.StateOverrides != nil {
+			stateDB := statedb.New(ctx, &k, txConfig)
+			if err := block.StateOverrides.Apply(stateDB); err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+			if err := stateDB.Commit(); err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+		}
+
+		blockCtx := k.NewBlockContext(ctx, cfg)
+		result := types.SimBlockResult{
+			Number:    (*hexutil.Big)(blockCtx.BlockNumber),
+			Timestamp: hexutil.Uint64(blockCtx.Time.Uint64()),
+			GasLimit:  hexutil.Uint64(blockCtx.GasLimit),
+			Miner:     blockCtx.Coinbase,
+			BaseFee:   (*hexutil.Big)(blockCtx.BaseFee),
+			Calls:     make([]types.SimCallResult, 0, len(block.Calls)),
+		}
+
+		txConfig.TxIndex = 0
+		txConfig.LogIndex = 0
+		for _, args := range block.Calls {
+			// ApplyMessageWithConfig expect correct nonce set in msg, a nonce given by the
+			// call must match the one of the simulated state
+			nonce := k.GetNonce(ctx, args.GetFrom())
+			if args.Nonce != nil && uint64(*args.Nonce) != nonce {
+				result.Calls = append(result.Calls, types.NewSimCallErrorResult(
+					simNonceError(args.GetFrom(), uint64(*args.Nonce), nonce),
+				))
+				continue
+			}
+			args.Nonce = (*hexutil.Uint64)(&nonce)
+
+			// a call which can't be executed is reported in its result, the state is left
+			// unchanged for the following calls
+			if req.GasCap != 0 && gasBudget == 0 {
+				result.Calls = append(result.Calls, types.NewSimCallErrorResult(
+					fmt.Errorf("gas cap of the simulation exhausted (%d)", req.GasCap),
+				))
+				continue
+			}
+			msg, err := args.ToMessage(gasBudget, cfg.BaseFee)
+			if err != nil {
+				result.Calls = append(result.Calls, types.NewSimCallErrorResult(err))
+				continue
+			}
+
+			rsp, err := k.ApplyMessageWithConfig(ctx, msg, nil, true, cfg, txConfig)
+			if err != nil {
+				result.Calls = append(result.Calls, types.NewSimCallErrorResult(err))
+				continue
+			}
+			if req.GasCap != 0 {
+				gasBudget -= rsp.GasUsed
+			}
+
+			// the nonce of contract creation is already increased by ApplyMessageWithConfig
+			if msg.To() != nil {
+				acct := k.GetAccountOrEmpty(ctx, msg.From())
+				acct.Nonce = nonce + 1
+				if err := k.SetAccount(ctx, msg.From(), acct); err != nil {
+					return nil, status.Error(codes.Internal, err.Error())
+				}
+			}
+
+			result.GasUsed += hexutil.Uint64(rsp.GasUsed)
+			result.Calls = append(result.Calls, types.NewSimCallResult(rsp, blockCtx.BlockNumber.Uint64()))
+			txConfig.TxIndex++
+			txConfig.LogIndex += uint(len(rsp.Logs))
+		}
+		results = append(results, result)
+	}
+
+	resultData, err := json.Marshal(results)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySimulateCallsResponse{
+		Data: resultData,
+	}, nil
+}
+
+// simNonceError returns the geth error of a simulated call whose nonce doesn't match
+// the one of the sender.
+func simNonceError(from common.Address, txNonce, stateNonce uint64) error {
+	nonceErr := core.ErrNonceTooHigh
+	if txNonce < stateNonce {
+		nonceErr = core.ErrNonceTooLow
+	}
+	return fmt.Errorf("%w: address %v, tx: %d state: %d", nonceErr, from.Hex(), txNonce, stateNonce)
+}
+
+// CreateAccessList implements eth_createAccessList rpc api, the call is executed
+// repeatedly with the access list collected by the previous run until the list
+// doesn't change anymore.
+func (k Keeper) CreateAccessList(c context.Context, req *types.EthCallRequest) (*types.QueryCreateAccessListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var args types.TransactionArgs
+	if err := json.Unmarshal(req.Args, &args); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	chainID, err := getChainID(ctx, req.ChainId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if err := setCallOverrides(cfg, req.Overrides, req.BlockOverrides); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// ApplyMessageWithConfig expect correct nonce set in msg
+	nonce := k.getNonceWithOverride(ctx, args.GetFrom(), cfg.Overrides)
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	// the created contract address is part of the access list of contract creations
+	var to common.Address
+	if args.To != nil {
+		to = *args.To
+	} else {
+		to = crypto.CreateAddress(args.GetFrom(), nonce)
+	}
+
+	// precompiles are excluded from the access list
+	blockCtx := k.NewBlockContext(ctx, cfg)
+	rules := cfg.ChainConfig.Rules(blockCtx.BlockNumber, cfg.ChainConfig.MergeNetsplitBlock != nil)
+	precompiles := vm.ActivePrecompiles(rules)
+
+	var accessList ethtypes.AccessList
+	if args.AccessList != nil {
+		accessList = *args.AccessList
+	}
+	prevTracer := logger.NewAccessListTracer(accessList, args.GetFrom(), to, precompiles)
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+
+	for {
+		accessList = prevTracer.AccessList()
+		args.AccessList = &accessList
+
+		msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		tracer := logger.NewAccessListTracer(accessList, args.GetFrom(), to, precompiles)
+		// pass false to not commit StateDB
+		res, err := k.ApplyMessageWithConfig(ctx, msg, tracer, false, cfg, txConfig)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		if tracer.Equal(prevTracer) {
+			return &types.QueryCreateAccessListResponse{
+				AccessList: types.NewAccessList(&accessList),
+				GasUsed:    res.GasUsed,
+				VmError:    res.VmError,
+			}, nil
+		}
+		prevTracer = tracer
+	}
+}
+
 // TraceTx configures a new tracer according to the provided configuration, and
 // executes the given message in the provided environment. The return value will
 // be tracer dependent.
@@ -467,6 +740,73 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 	}, nil
 }
 
+// TraceCall configures a new tracer according to the provided configuration, and
+// executes the call built from the transaction arguments on top of the queried block,
+// with the state and block overrides applied. The return value will be tracer dependent.
+func (k Keeper) TraceCall(c context.Context, req *types.QueryTraceCallRequest) (*types.QueryTraceTxResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.TraceConfig != nil && req.TraceConfig.Limit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "output limit cannot be negative, got %d", req.TraceConfig.Limit)
+	}
+
+	var args types.TransactionArgs
+	if err := json.Unmarshal(req.Args, &args); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if req.BlockNumber > 0 {
+		ctx = ctx.WithBlockHeight(req.BlockNumber)
+	}
+	ctx = ctx.WithBlockTime(req.BlockTime)
+	ctx = ctx.WithHeaderHash(common.Hex2Bytes(req.BlockHash))
+	chainID, err := getChainID(ctx, req.ChainId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load evm config: %s", err.Error())
+	}
+	if err := setCallOverrides(cfg, req.Overrides, req.BlockOverrides); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// ApplyMessageWithConfig expect correct nonce set in msg
+	nonce := k.getNonceWithOverride(ctx, args.GetFrom(), cfg.Overrides)
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var tracerConfig json.RawMessage
+	if req.TraceConfig != nil && req.TraceConfig.TracerJsonConfig != "" {
+		// ignore error. default to no traceConfig
+		_ = json.Unmarshal([]byte(req.TraceConfig.TracerJsonConfig), &tracerConfig)
+	}
+
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash().Bytes()))
+	result, _, err := k.traceMsg(ctx, cfg, txConfig, msg, req.TraceConfig, false, tracerConfig)
+	if err != nil {
+		// error will be returned with detail status from traceMsg
+		return nil, err
+	}
+
+	resultData, err := json.Marshal(result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTraceTxResponse{
+		Data: resultData,
+	}, nil
+}
+
 // TraceBlock configures a new tracer according to the provided configuration, and
 // executes the given message in the provided environment for all the transactions in the queried block.
 // The return value will be tracer dependent.
@@ -475,6 +815,77 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
+	results := make([]*types.TxTraceResult, 0, len(req.Txs))
+	state, err := k.traceBlock(sdk.UnwrapSDKContext(c), req, func(_ uint, result *types.TxTraceResult) error {
+		results = append(results, result)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resultData, err := json.Marshal(results)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTraceBlockResponse{
+		Data:  resultData,
+		State: state,
+	}, nil
+}
+
+// TraceBlockStream traces the transactions of the queried block like TraceBlock and sends
+// the result of each transaction as soon as it's traced. The stream isn't given a context
+// by the gRPC server, so the state is read at the height of the request header.
+func (k Keeper) TraceBlockStream(req *types.QueryTraceBlockRequest, stream types.Query_TraceBlockStreamServer) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.State != nil {
+		return status.Error(codes.InvalidArgument, "the state of a streamed block trace must not be set")
+	}
+	if k.queryContext == nil {
+		return status.Error(codes.Unimplemented, "streaming queries are not supported by the node")
+	}
+
+	var height int64
+	if md, ok := metadata.FromIncomingContext(stream.Context()); ok {
+		if heights := md.Get(grpctypes.GRPCBlockHeightHeader); len(heights) == 1 {
+			var err error
+			height, err = strconv.ParseInt(heights[0], 10, 64)
+			if err != nil {
+				return status.Errorf(codes.InvalidArgument, "invalid height header %q: %v", grpctypes.GRPCBlockHeightHeader, err)
+			}
+		}
+	}
+	ctx, err := k.queryContext(height, false)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx = ctx.WithContext(stream.Context())
+
+	_, err = k.traceBlock(ctx, req, func(txIndex uint, result *types.TxTraceResult) error {
+		data, err := json.Marshal(result)
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		return stream.Send(&types.QueryTraceBlockStreamResponse{
+			TxIndex: uint64(txIndex),
+			Data:    data,
+		})
+	})
+	return err
+}
+
+// traceBlock traces the transactions of the block and passes the result of each of them
+// to emit in tx order, it stops at the first error of emit. It returns the state written
+// by the block if the state of the request is set, for the next chunk of the block.
+func (k Keeper) traceBlock(
+	ctx sdk.Context,
+	req *types.QueryTraceBlockRequest,
+	emit func(txIndex uint, result *types.TxTraceResult) error,
+) (*types.TraceBlockState, error) {
 	if req.TraceConfig != nil && req.TraceConfig.Limit < 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "output limit cannot be negative, got %d", req.TraceConfig.Limit)
 	}
@@ -486,6 +897,206 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 		contextHeight = 1
 	}
 
+	ctx = ctx.WithBlockHeight(contextHeight)
+	ctx = ctx.WithBlockTime(req.BlockTime)
+	ctx = ctx.WithHeaderHash(common.Hex2Bytes(req.BlockHash))
+	chainID, err := getChainID(ctx, req.ChainId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to load evm config")
+	}
+	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
+
+	var tracerConfig json.RawMessage
+	if req.TraceConfig != nil && req.TraceConfig.TracerJsonConfig != "" {
+		// ignore error. default to no traceConfig
+		_ = json.Unmarshal([]byte(req.TraceConfig.TracerJsonConfig), &tracerConfig)
+	}
+
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash().Bytes()))
+	if req.State != nil {
+		// the state written by the previous chunks of the block is carried by the request
+		cfg.StateDiff, err = stateDiffFromProto(req.State)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if err := k.applyStateDiff(ctx, cfg.StateDiff); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		txConfig.TxIndex = uint(req.State.TxIndex)
+		txConfig.LogIndex = uint(req.State.LogIndex)
+	} else {
+		for i, tx := range req.Predecessors {
+			ethTx := tx.AsTransaction()
+			msg, err := ethTx.AsMessage(signer, cfg.BaseFee)
+			if err != nil {
+				continue
+			}
+			txConfig.TxHash = ethTx.Hash()
+			txConfig.TxIndex = uint(i)
+			rsp, err := k.ApplyMessageWithConfig(ctx, msg, types.NewNoOpTracer(), true, cfg, txConfig)
+			if err != nil {
+				continue
+			}
+			txConfig.LogIndex += uint(len(rsp.Logs))
+		}
+		txConfig.TxIndex = uint(len(req.Predecessors))
+	}
+
+	firstTxIndex := txConfig.TxIndex
+	if req.TraceConfig != nil && req.TraceConfig.Parallel {
+		txConfig, err = k.traceTxsParallel(ctx, cfg, txConfig, signer, req.Txs, req.TraceConfig, tracerConfig, emit)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		for i, tx := range req.Txs {
+			result := types.TxTraceResult{}
+			ethTx := tx.AsTransaction()
+			txConfig.TxHash = ethTx.Hash()
+			txConfig.TxIndex = firstTxIndex + uint(i)
+			traceResult, logIndex, err := k.traceTx(ctx, cfg, txConfig, signer, ethTx, req.TraceConfig, true, tracerConfig)
+			if err != nil {
+				result.Error = err.Error()
+			} else {
+				txConfig.LogIndex = logIndex
+				result.Result = traceResult
+			}
+			if err := emit(txConfig.TxIndex, &result); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if req.State == nil {
+		return nil, nil
+	}
+	state := stateDiffToProto(cfg.StateDiff)
+	state.TxIndex = uint64(firstTxIndex) + uint64(len(req.Txs))
+	state.LogIndex = uint64(txConfig.LogIndex)
+	return state, nil
+}
+
+// stateDiffFromProto returns the state diff carried by the trace block state
+func stateDiffFromProto(state *types.TraceBlockState) (statedb.StateDiff, error) {
+	diff := make(statedb.StateDiff, len(state.Accounts))
+	for _, acct := range state.Accounts {
+		if err := ethermint.ValidateAddress(acct.Address); err != nil {
+			return nil, err
+		}
+		if acct.Balance.IsNil() || acct.Balance.IsNegative() {
+			return nil, fmt.Errorf("invalid balance of account %s", acct.Address)
+		}
+		storage := make(statedb.Storage, len(acct.Storage))
+		for _, state := range acct.Storage {
+			storage[common.HexToHash(state.Key)] = common.HexToHash(state.Value)
+		}
+		diff[common.HexToAddress(acct.Address)] = &statedb.AccountDiff{
+			Deleted: acct.Deleted,
+			Exists:  acct.Exists,
+			Account: statedb.Account{
+				Nonce:    acct.Nonce,
+				Balance:  acct.Balance.BigInt(),
+				CodeHash: acct.CodeHash,
+			},
+			Code:    acct.Code,
+			Storage: storage,
+		}
+	}
+	return diff, nil
+}
+
+// stateDiffToProto returns the trace block state carrying the state diff, the accounts
+// and their storage are sorted for determinism.
+func stateDiffToProto(diff statedb.StateDiff) *types.TraceBlockState {
+	addresses := make([]common.Address, 0, len(diff))
+	for address := range diff {
+		addresses = append(addresses, address)
+	}
+	sort.Slice(addresses, func(i, j int) bool {
+		return bytes.Compare(addresses[i].Bytes(), addresses[j].Bytes()) < 0
+	})
+
+	state := &types.TraceBlockState{
+		Accounts: make([]types.AccountStateDiff, 0, len(diff)),
+	}
+	for _, address := range addresses {
+		acct := diff[address]
+		accountDiff := types.AccountStateDiff{
+			Address: address.Hex(),
+			Deleted: acct.Deleted,
+			Exists:  acct.Exists,
+			Balance: sdkmath.ZeroInt(),
+			Code:    acct.Code,
+			Storage: make([]types.State, 0, len(acct.Storage)),
+		}
+		if acct.Exists {
+			accountDiff.Nonce = acct.Account.Nonce
+			accountDiff.Balance = sdkmath.NewIntFromBigInt(acct.Account.Balance)
+			accountDiff.CodeHash = acct.Account.CodeHash
+		}
+		for _, key := range acct.Storage.SortedKeys() {
+			accountDiff.Storage = append(accountDiff.Storage, types.NewState(key, acct.Storage[key]))
+		}
+		state.Accounts = append(state.Accounts, accountDiff)
+	}
+	return state
+}
+
+// applyStateDiff writes the accounts of the state diff like `StateDB.Commit` does
+func (k *Keeper) applyStateDiff(ctx sdk.Context, diff statedb.StateDiff) error {
+	addresses := make([]common.Address, 0, len(diff))
+	for address := range diff {
+		addresses = append(addresses, address)
+	}
+	sort.Slice(addresses, func(i, j int) bool {
+		return bytes.Compare(addresses[i].Bytes(), addresses[j].Bytes()) < 0
+	})
+
+	for _, address := range addresses {
+		acct := diff[address]
+		if acct.Deleted {
+			if err := k.DeleteAccount(ctx, address); err != nil {
+				return errorsmod.Wrap(err, "failed to delete account")
+			}
+		}
+		if !acct.Exists {
+			continue
+		}
+		if acct.Code != nil {
+			k.SetCode(ctx, acct.Account.CodeHash, acct.Code)
+		}
+		if err := k.SetAccount(ctx, address, acct.Account); err != nil {
+			return errorsmod.Wrap(err, "failed to set account")
+		}
+		for _, key := range acct.Storage.SortedKeys() {
+			k.SetState(ctx, address, key, acct.Storage[key].Bytes())
+		}
+	}
+	return nil
+}
+
+// IntermediateRoots re-executes all the transactions in the queried block and returns
+// the intermediate root after each of them, which chains the commitments of the states
+// written by the transactions. The roots only commit to the EVM accounts and storage
+// written by the transactions, and to the balances changed by their fees and refunds,
+// not to the whole application state.
+func (k Keeper) IntermediateRoots(c context.Context, req *types.QueryTraceBlockRequest) (*types.QueryIntermediateRootsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	// minus one to get the context of block beginning
+	contextHeight := req.BlockNumber - 1
+	if contextHeight < 1 {
+		// 0 is a special value in `ContextWithHeight`
+		contextHeight = 1
+	}
+
 	ctx := sdk.UnwrapSDKContext(c)
 	ctx = ctx.WithBlockHeight(contextHeight)
 	ctx = ctx.WithBlockTime(req.BlockTime)
@@ -499,34 +1110,236 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to load evm config")
 	}
+	cfg.IntermediateRoot = &common.Hash{}
 	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
-	txsLength := len(req.Txs)
-	results := make([]*types.TxTraceResult, 0, txsLength)
+	roots := make([][]byte, 0, len(req.Txs))
+	txErrors := make([]string, 0, len(req.Txs))
 
 	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash().Bytes()))
 	for i, tx := range req.Txs {
-		result := types.TxTraceResult{}
 		ethTx := tx.AsTransaction()
 		txConfig.TxHash = ethTx.Hash()
 		txConfig.TxIndex = uint(i)
-		traceResult, logIndex, err := k.traceTx(ctx, cfg, txConfig, signer, ethTx, req.TraceConfig, true, nil)
+
+		// a tx which can't be replayed leaves the state and the root unchanged
+		var txError string
+		msg, err := ethTx.AsMessage(signer, cfg.BaseFee)
+		if err == nil {
+			var res *types.MsgEthereumTxResponse
+			if res, err = k.replayTx(ctx, cfg, txConfig, msg); err == nil {
+				txConfig.LogIndex += uint(len(res.Logs))
+			}
+		}
 		if err != nil {
-			result.Error = err.Error()
-		} else {
-			txConfig.LogIndex = logIndex
-			result.Result = traceResult
+			txError = fmt.Sprintf("failed to apply transaction %s: %s", ethTx.Hash().Hex(), err.Error())
 		}
-		results = append(results, &result)
+		roots = append(roots, cfg.IntermediateRoot.Bytes())
+		txErrors = append(txErrors, txError)
 	}
 
-	resultData, err := json.Marshal(results)
+	return &types.QueryIntermediateRootsResponse{
+		Roots:  roots,
+		Errors: txErrors,
+	}, nil
+}
+
+// replayTx applies the message the same way as its tx is executed in a block: the ante
+// handler checks and increases the nonce of the sender and deducts the full gas cost
+// before the message is applied, the leftover gas is refunded afterwards. Nothing is
+// written if it fails.
+func (k *Keeper) replayTx(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	txConfig statedb.TxConfig,
+	msg core.Message,
+) (*types.MsgEthereumTxResponse, error) {
+	tmpCtx, commit := ctx.CacheContext()
+
+	acct := k.accountKeeper.GetAccount(tmpCtx, msg.From().Bytes())
+	if acct == nil {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnknownAddress, "account %s is nil", msg.From())
+	}
+	if nonce := acct.GetSequence(); msg.Nonce() != nonce {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidSequence, "invalid nonce; got %d, expected %d", msg.Nonce(), nonce)
+	}
+	if err := acct.SetSequence(msg.Nonce() + 1); err != nil {
+		return nil, err
+	}
+	k.accountKeeper.SetAccount(tmpCtx, acct)
+
+	fee := new(big.Int).Mul(new(big.Int).SetUint64(msg.Gas()), msg.GasPrice())
+	if fee.Sign() > 0 {
+		fees := sdk.Coins{{Denom: cfg.Params.EvmDenom, Amount: sdkmath.NewIntFromBigInt(fee)}}
+		if err := k.DeductTxCostsFromUserBalance(tmpCtx, fees, msg.From()); err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := k.ApplyMessageWithConfig(tmpCtx, msg, nil, true, cfg, txConfig)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
+	}
+	if err := k.RefundGas(tmpCtx, msg, msg.Gas()-res.GasUsed, cfg.Params.EvmDenom); err != nil {
+		return nil, err
 	}
 
-	return &types.QueryTraceBlockResponse{
-		Data: resultData,
-	}, nil
+	if cfg.IntermediateRoot != nil {
+		// the fee deduction and the refund are written outside of the EVM state, the final
+		// balances of the sender and of the fee collector are chained into the root too
+		feeCollector := common.BytesToAddress(authtypes.NewModuleAddress(authtypes.FeeCollectorName))
+		*cfg.IntermediateRoot = crypto.Keccak256Hash(
+			cfg.IntermediateRoot.Bytes(),
+			msg.From().Bytes(), common.LeftPadBytes(k.GetBalance(tmpCtx, msg.From()).Bytes(), 32),
+			feeCollector.Bytes(), common.LeftPadBytes(k.GetBalance(tmpCtx, feeCollector).Bytes(), 32),
+		)
+	}
+
+	commit()
+	return res, nil
+}
+
+// StorageRangeAt returns the storage entries of the contract, ordered by key and starting
+// from the start key, after the predecessor transactions of the queried block are replayed.
+// The key of the first entry beyond the max results is returned to continue the iteration.
+// Unlike geth, which orders the entries by the hash of their key, the start and next keys
+// are plain storage keys.
+func (k Keeper) StorageRangeAt(c context.Context, req *types.QueryStorageRangeAtRequest) (*types.QueryStorageRangeAtResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := ethermint.ValidateAddress(req.Address); err != nil {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			types.ErrZeroAddress.Error(),
+		)
+	}
+
+	// minus one to get the context of block beginning
+	contextHeight := req.BlockNumber - 1
+	if contextHeight < 1 {
+		// 0 is a special value in `ContextWithHeight`
+		contextHeight = 1
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx = ctx.WithBlockHeight(contextHeight)
+	ctx = ctx.WithBlockTime(req.BlockTime)
+	ctx = ctx.WithHeaderHash(common.Hex2Bytes(req.BlockHash))
+	chainID, err := getChainID(ctx, req.ChainId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load evm config: %s", err.Error())
+	}
+	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
+
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash().Bytes()))
+	for i, tx := range req.Predecessors {
+		ethTx := tx.AsTransaction()
+		msg, err := ethTx.AsMessage(signer, cfg.BaseFee)
+		if err != nil {
+			continue
+		}
+		txConfig.TxHash = ethTx.Hash()
+		txConfig.TxIndex = uint(i)
+		rsp, err := k.ApplyMessageWithConfig(ctx, msg, types.NewNoOpTracer(), true, cfg, txConfig)
+		if err != nil {
+			continue
+		}
+		txConfig.LogIndex += uint(len(rsp.Logs))
+	}
+
+	var start []byte
+	if len(req.StartKey) > 0 {
+		start = common.BytesToHash(req.StartKey).Bytes()
+	}
+
+	res := &types.QueryStorageRangeAtResponse{
+		Storage: []types.State{},
+	}
+	k.forEachStorageFrom(ctx, common.HexToAddress(req.Address), start, func(key, value common.Hash) bool {
+		if uint64(len(res.Storage)) == req.MaxResults {
+			res.NextKey = key.Bytes()
+			return false
+		}
+		res.Storage = append(res.Storage, types.NewState(key, value))
+		return true
+	})
+	return res, nil
+}
+
+// AccountRange returns the state of the accounts, ordered by address and starting from
+// the start address. The address of the first account beyond the max results is returned
+// to continue the iteration. Unlike geth, which orders the accounts by the hash of their
+// address, the start and next keys are plain addresses.
+func (k Keeper) AccountRange(c context.Context, req *types.QueryAccountRangeRequest) (*types.QueryAccountRangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	res := &types.QueryAccountRangeResponse{
+		Accounts: []types.AccountDump{},
+	}
+	// the accounts are stored by address, so the iteration is started at the start address
+	// and the pages are read until an account beyond the max results is found, skipping
+	// the accounts which aren't ethereum ones
+	pageReq := &query.PageRequest{Key: req.Start, Limit: accountRangePageSize}
+	for res.Next == nil {
+		accountsRes, err := k.accountKeeper.Accounts(c, &authtypes.QueryAccountsRequest{Pagination: pageReq})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, anyAccount := range accountsRes.Accounts {
+			account, ok := anyAccount.GetCachedValue().(authtypes.AccountI)
+			if !ok {
+				return nil, status.Errorf(codes.Internal, "invalid account type %s", anyAccount.TypeUrl)
+			}
+			accAddr := account.GetAddress()
+			if len(accAddr) != common.AddressLength {
+				continue
+			}
+
+			address := common.BytesToAddress(accAddr)
+			if uint64(len(res.Accounts)) == req.MaxResults {
+				res.Next = address.Bytes()
+				break
+			}
+			res.Accounts = append(res.Accounts, k.accountDump(ctx, address, req.NoCode, req.NoStorage))
+		}
+
+		if accountsRes.Pagination == nil || len(accountsRes.Pagination.NextKey) == 0 {
+			break
+		}
+		pageReq = &query.PageRequest{Key: accountsRes.Pagination.NextKey, Limit: accountRangePageSize}
+	}
+	return res, nil
+}
+
+// accountDump returns the state of the ethereum account
+func (k Keeper) accountDump(ctx sdk.Context, address common.Address, noCode, noStorage bool) types.AccountDump {
+	acct := k.GetAccountOrEmpty(ctx, address)
+	dump := types.AccountDump{
+		Address:  address.Hex(),
+		Balance:  sdkmath.NewIntFromBigInt(acct.Balance),
+		Nonce:    acct.Nonce,
+		CodeHash: acct.CodeHash,
+		Storage:  []types.State{},
+	}
+	if !noCode && acct.IsContract() {
+		dump.Code = k.GetCode(ctx, common.BytesToHash(acct.CodeHash))
+	}
+	if !noStorage {
+		k.ForEachStorage(ctx, address, func(key, value common.Hash) bool {
+			dump.Storage = append(dump.Storage, types.NewState(key, value))
+			return true
+		})
+	}
+	return dump
 }
 
 // traceTx do trace on one transaction, it returns a tuple: (traceResult, nextLogIndex, error).
@@ -539,6 +1352,159 @@ func (k *Keeper) traceTx(
 	traceConfig *types.TraceConfig,
 	commitMessage bool,
 	tracerJSONConfig json.RawMessage,
+) (*interface{}, uint, error) {
+	msg, err := tx.AsMessage(signer, cfg.BaseFee)
+	if err != nil {
+		return nil, 0, status.Error(codes.Internal, err.Error())
+	}
+	return k.traceMsg(ctx, cfg, txConfig, msg, traceConfig, commitMessage, tracerJSONConfig)
+}
+
+// traceTxsParallel traces the txs in a pool of workers and passes their results to emit in
+// tx order as soon as they are traced, the tx index of the first tx is the one of the tx config.
+// The pre-state of each tx is a cache branch on top of the pre-state of the previous tx, in
+// which the previous tx is replayed without being traced. A branch isn't written anymore
+// once it's handed to a worker, so the workers only read from the branches below them.
+// The branches are written down into the context every maxTraceCacheDepth txs once their
+// traces are done, which bounds the depth of the reads. The workers of all the queries
+// share the trace worker slots of the keeper. The remaining traces are canceled at the
+// first error of emit, which is returned.
+func (k *Keeper) traceTxsParallel(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	txConfig statedb.TxConfig,
+	signer ethtypes.Signer,
+	txs []*types.MsgEthereumTx,
+	traceConfig *types.TraceConfig,
+	tracerJSONConfig json.RawMessage,
+	emit func(txIndex uint, result *types.TxTraceResult) error,
+) (statedb.TxConfig, error) {
+	type traceTask struct {
+		index    int
+		ctx      sdk.Context
+		txConfig statedb.TxConfig
+		tx       *ethtypes.Transaction
+	}
+
+	goCtx, cancel := context.WithCancel(ctx.Context())
+	defer cancel()
+	ctx = ctx.WithContext(goCtx)
+
+	firstTxIndex := txConfig.TxIndex
+	// results holds the results of the txs traced before the ones not emitted yet
+	results := make([]*types.TxTraceResult, len(txs))
+	var (
+		mu      sync.Mutex
+		next    int
+		emitErr error
+	)
+	done := func(index int, result *types.TxTraceResult) {
+		mu.Lock()
+		defer mu.Unlock()
+		results[index] = result
+		for ; next < len(results) && results[next] != nil; next++ {
+			if emitErr == nil {
+				if emitErr = emit(firstTxIndex+uint(next), results[next]); emitErr != nil {
+					cancel()
+				}
+			}
+			results[next] = nil
+		}
+	}
+
+	tasks := make(chan traceTask, len(txs))
+
+	workers := cap(k.traceWorkers)
+	if workers > len(txs) {
+		workers = len(txs)
+	}
+	var wg, pending sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for task := range tasks {
+				result := types.TxTraceResult{}
+				select {
+				case k.traceWorkers <- struct{}{}:
+					traceResult, _, err := k.traceTx(task.ctx, cfg, task.txConfig, signer, task.tx, traceConfig, false, tracerJSONConfig)
+					<-k.traceWorkers
+					if err != nil {
+						result.Error = err.Error()
+					} else {
+						result.Result = traceResult
+					}
+				case <-goCtx.Done():
+					result.Error = goCtx.Err().Error()
+				}
+				done(task.index, &result)
+				pending.Done()
+			}
+		}()
+	}
+
+	preState := ctx
+	var writes []func()
+	for i, tx := range txs {
+		if goCtx.Err() != nil {
+			break
+		}
+		ethTx := tx.AsTransaction()
+		txConfig.TxHash = ethTx.Hash()
+		txConfig.TxIndex = firstTxIndex + uint(i)
+
+		// the gas meters aren't safe for concurrent use
+		taskCtx, _ := preState.CacheContext()
+		pending.Add(1)
+		tasks <- traceTask{
+			index:    i,
+			ctx:      taskCtx.WithGasMeter(sdk.NewInfiniteGasMeter()),
+			txConfig: txConfig,
+			tx:       ethTx,
+		}
+
+		if i == len(txs)-1 && cfg.StateDiff == nil {
+			// the state after the last tx is only needed by the next chunk
+			break
+		}
+		var write func()
+		preState, write = preState.CacheContext()
+		writes = append(writes, write)
+		if msg, err := ethTx.AsMessage(signer, cfg.BaseFee); err == nil {
+			if rsp, err := k.ApplyMessageWithConfig(preState, msg, types.NewNoOpTracer(), true, cfg, txConfig); err == nil {
+				txConfig.LogIndex += uint(len(rsp.Logs))
+			}
+		}
+
+		if len(writes) == maxTraceCacheDepth {
+			// no worker reads from the branches anymore once the dispatched txs are traced
+			pending.Wait()
+			for w := len(writes) - 1; w >= 0; w-- {
+				writes[w]()
+			}
+			writes = writes[:0]
+			preState = ctx
+		}
+	}
+	close(tasks)
+	wg.Wait()
+
+	if emitErr == nil && next < len(txs) {
+		// the query is canceled before all the txs are dispatched
+		return txConfig, status.Error(codes.Canceled, goCtx.Err().Error())
+	}
+	return txConfig, emitErr
+}
+
+// traceMsg do trace on one message, it returns a tuple: (traceResult, nextLogIndex, error).
+func (k *Keeper) traceMsg(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	txConfig statedb.TxConfig,
+	msg core.Message,
+	traceConfig *types.TraceConfig,
+	commitMessage bool,
+	tracerJSONConfig json.RawMessage,
 ) (*interface{}, uint, error) {
 	// Assemble the structured logger or the JavaScript tracer
 	var (
@@ -547,10 +1513,6 @@ func (k *Keeper) traceTx(
 		err       error
 		timeout   = defaultTraceTimeout
 	)
-	msg, err := tx.AsMessage(signer, cfg.BaseFee)
-	if err != nil {
-		return nil, 0, status.Error(codes.Internal, err.Error())
-	}
 
 	if traceConfig == nil {
 		traceConfig = &types.TraceConfig{}
@@ -579,7 +1541,13 @@ func (k *Keeper) traceTx(
 	}
 
 	if traceConfig.Tracer != "" {
-		if tracer, err = tracers.New(traceConfig.Tracer, tCtx, tracerJSONConfig); err != nil {
+		// the tracers registered by the app take precedence over the go-ethereum ones
+		if constructor, found := types.LookupTracer(traceConfig.Tracer); found {
+			tracer, err = constructor(tCtx, tracerJSONConfig)
+		} else {
+			tracer, err = tracers.New(traceConfig.Tracer, tCtx, tracerJSONConfig)
+		}
+		if err != nil {
 			return nil, 0, status.Error(codes.Internal, err.Error())
 		}
 	}
@@ -633,6 +1601,38 @@ func (k Keeper) BaseFee(c context.Context, _ *types.QueryBaseFeeRequest) (*types
 	return res, nil
 }
 
+// setCallOverrides parses the json encoded state and block overrides of the request
+// into the EVM config, they are left nil if not provided.
+func setCallOverrides(cfg *statedb.EVMConfig, overrides, blockOverrides []byte) error {
+	if len(overrides) > 0 {
+		cfg.Overrides = new(types.StateOverride)
+		if err := json.Unmarshal(overrides, cfg.Overrides); err != nil {
+			return err
+		}
+	}
+	if len(blockOverrides) > 0 {
+		cfg.BlockOverrides = new(types.BlockOverrides)
+		if err := json.Unmarshal(blockOverrides, cfg.BlockOverrides); err != nil {
+			return err
+		}
+		// the message gas price is derived from the overridden base fee
+		if cfg.BlockOverrides.BaseFee != nil {
+			cfg.BaseFee = cfg.BlockOverrides.BaseFee.ToInt()
+		}
+	}
+	return nil
+}
+
+// getNonceWithOverride returns the nonce of the address, taking the state overrides into account
+func (k Keeper) getNonceWithOverride(ctx sdk.Context, addr common.Address, overrides *types.StateOverride) uint64 {
+	if overrides != nil {
+		if account, ok := (*overrides)[addr]; ok && account.Nonce != nil {
+			return uint64(*account.Nonce)
+		}
+	}
+	return k.GetNonce(ctx, addr)
+}
+
 // getChainID parse chainID from current context if not provided
 func getChainID(ctx sdk.Context, chainID int64) (*big.Int, error) {
 	if chainID == 0 {
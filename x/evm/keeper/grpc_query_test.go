@@ -1,14 +1,21 @@
 package keeper_test
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math/big"
+	"strings"
 
 	sdkmath "cosmossdk.io/math"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 	ethlogger "github.com/ethereum/go-ethereum/eth/tracers/logger"
@@ -17,6 +24,8 @@ import (
 	"github.com/evmos/ethermint/x/evm/statedb"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/evmos/ethermint/server/config"
 	ethermint "github.com/evmos/ethermint/types"
@@ -804,6 +813,18 @@ func (suite *KeeperTestSuite) TestTraceTx() {
 			expPass:       true,
 			traceResponse: "[]",
 		},
+		{
+			msg: "registered native tracer with json config",
+			malleate: func() {
+				traceConfig = &types.TraceConfig{
+					Tracer:           "precompileCallTracer",
+					TracerJsonConfig: `{"addresses":["0x0000000000000000000000000000000000000001"]}`,
+				}
+				predecessors = []*types.MsgEthereumTx{}
+			},
+			expPass:       true,
+			traceResponse: "[]",
+		},
 		{
 			msg: "default trace with enableFeemarket",
 			malleate: func() {
@@ -1026,6 +1047,17 @@ func (suite *KeeperTestSuite) TestTraceBlock() {
 			expPass:       true,
 			traceResponse: "[{\"result\":[]}]",
 		},
+		{
+			msg: "registered native tracer with invalid json config",
+			malleate: func() {
+				traceConfig = &types.TraceConfig{
+					Tracer:           "precompileCallTracer",
+					TracerJsonConfig: `{"addresses":"0x0000000000000000000000000000000000000001"}`,
+				}
+			},
+			expPass:       true,
+			traceResponse: "[{\"error\":\"rpc error: code = Internal desc = json: cannot unmarshal string into Go struct field precompileCallTracerConfig.addresses of type []common.",
+		},
 		{
 			msg: "default trace with enableFeemarket and filtered return",
 			malleate: func() {
@@ -1155,6 +1187,418 @@ func (suite *KeeperTestSuite) TestTraceBlock() {
 	suite.enableFeemarket = false // reset flag
 }
 
+func (suite *KeeperTestSuite) TestTraceCall() {
+	var (
+		traceConfig *types.TraceConfig
+		overrides   []byte
+	)
+
+	contract := tests.GenerateAddress()
+	key := common.BigToHash(big.NewInt(0))
+	value := common.BigToHash(big.NewInt(42))
+	// runtime code returning the storage value at slot 0:
+	// PUSH1 0 SLOAD PUSH1 0 MSTORE PUSH1 32 PUSH1 0 RETURN
+	code := hexutil.Bytes{0x60, 0x00, 0x54, 0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3}
+	stateOverride := func() []byte {
+		bz, err := json.Marshal(types.StateOverride{
+			contract: {Code: &code, StateDiff: &map[common.Hash]common.Hash{key: value}},
+		})
+		suite.Require().NoError(err)
+		return bz
+	}
+
+	testCases := []struct {
+		msg           string
+		malleate      func()
+		expPass       bool
+		traceResponse string
+	}{
+		{
+			"default trace without overrides",
+			func() {},
+			true,
+			"\"failed\":false,\"returnValue\":\"\",\"structLogs\":[]}",
+		},
+		{
+			"default trace with state overrides",
+			func() {
+				overrides = stateOverride()
+			},
+			true,
+			"\"failed\":false,\"returnValue\":\"000000000000000000000000000000000000000000000000000000000000002a\",\"structLogs\":[{\"pc\":0,\"op\":\"PUSH1\",",
+		},
+		{
+			"call tracer with state overrides",
+			func() {
+				traceConfig = &types.TraceConfig{Tracer: "callTracer"}
+				overrides = stateOverride()
+			},
+			true,
+			"{\"type\":\"CALL\",\"from\":\"" + strings.ToLower(suite.address.Hex()),
+		},
+		{
+			"flat call tracer with state overrides",
+			func() {
+				traceConfig = &types.TraceConfig{Tracer: "flatCallTracer"}
+				overrides = stateOverride()
+			},
+			true,
+			"\"output\":\"0x000000000000000000000000000000000000000000000000000000000000002a\"},\"subtraces\":0,\"traceAddress\":[]",
+		},
+		{
+			"fail - negative limit",
+			func() {
+				traceConfig = &types.TraceConfig{Limit: -1}
+			},
+			false,
+			"",
+		},
+		{
+			"fail - invalid state overrides",
+			func() {
+				overrides = []byte("{")
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest()
+			traceConfig = nil
+			overrides = nil
+			tc.malleate()
+
+			args, err := json.Marshal(&types.TransactionArgs{From: &suite.address, To: &contract})
+			suite.Require().NoError(err)
+			req := &types.QueryTraceCallRequest{
+				Args:        args,
+				GasCap:      uint64(config.DefaultGasCap),
+				TraceConfig: traceConfig,
+				Overrides:   overrides,
+				BlockNumber: suite.ctx.BlockHeight(),
+				BlockTime:   suite.ctx.BlockTime(),
+				ChainId:     suite.app.EvmKeeper.ChainID().Int64(),
+			}
+
+			res, err := suite.queryClient.TraceCall(sdk.WrapSDKContext(suite.ctx), req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Contains(string(res.Data), tc.traceResponse)
+
+				// overrides must not be persisted
+				suite.Require().Empty(suite.app.EvmKeeper.GetCode(suite.ctx, crypto.Keccak256Hash(code)))
+				suite.Require().Equal(common.Hash{}, suite.app.EvmKeeper.GetState(suite.ctx, contract, key))
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestIntermediateRoots() {
+	suite.SetupTest()
+	// Deploy contract
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, sdkmath.NewIntWithDecimal(1000, 18).BigInt())
+	suite.Require().NoError(suite.app.EvmKeeper.SetBalance(suite.ctx, suite.address, sdkmath.NewIntWithDecimal(1, 18).BigInt()))
+	suite.Commit()
+
+	// create multiple transactions in the same block, replayed with the ante handling
+	chainID := suite.app.EvmKeeper.ChainID()
+	nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+	gasPrice := big.NewInt(1_000_000_000)
+	recipient := common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec")
+	transferData, err := types.ERC20Contract.ABI.Pack("transfer", recipient, sdkmath.NewIntWithDecimal(1, 18).BigInt())
+	suite.Require().NoError(err)
+	buildTx := func(nonce uint64) *types.MsgEthereumTx {
+		tx := types.NewTx(chainID, nonce, &contractAddr, nil, 100_000, gasPrice, nil, nil, transferData, nil)
+		tx.From = suite.address.Hex()
+		suite.Require().NoError(tx.Sign(ethtypes.LatestSignerForChainID(chainID), suite.signer))
+		return tx
+	}
+	firstTx := buildTx(nonce)
+	secondTx := buildTx(nonce + 1)
+	req := &types.QueryTraceBlockRequest{
+		Txs: []*types.MsgEthereumTx{firstTx, secondTx},
+	}
+
+	// re-execute the block twice on the same state
+	ctx, _ := suite.ctx.CacheContext()
+	balance := suite.app.EvmKeeper.GetBalance(ctx, suite.address)
+	res, err := suite.app.EvmKeeper.IntermediateRoots(sdk.WrapSDKContext(ctx), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{"", ""}, res.Errors)
+
+	// the nonce is increased and the fees are paid like in the block execution
+	suite.Require().Equal(nonce+2, suite.app.EvmKeeper.GetNonce(ctx, suite.address))
+	suite.Require().Equal(-1, suite.app.EvmKeeper.GetBalance(ctx, suite.address).Cmp(balance))
+
+	ctx, _ = suite.ctx.CacheContext()
+	res2, err := suite.app.EvmKeeper.IntermediateRoots(sdk.WrapSDKContext(ctx), req)
+	suite.Require().NoError(err)
+
+	suite.Require().Len(res.Roots, 2)
+	suite.Require().Equal(res.Roots, res2.Roots)
+	suite.Require().NotEqual(common.Hash{}, common.BytesToHash(res.Roots[0]))
+	suite.Require().NotEqual(res.Roots[0], res.Roots[1])
+
+	// the fees paid to the fee collector are part of the roots
+	ctx, _ = suite.ctx.CacheContext()
+	coins := sdk.NewCoins(sdk.NewInt64Coin(suite.app.EvmKeeper.GetParams(ctx).EvmDenom, 1))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(ctx, types.ModuleName, coins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, coins))
+	feeRes, err := suite.app.EvmKeeper.IntermediateRoots(sdk.WrapSDKContext(ctx), req)
+	suite.Require().NoError(err)
+	suite.Require().NotEqual(res.Roots[0], feeRes.Roots[0])
+
+	// the roots are chained, re-executing only the first transaction gives the same root
+	ctx, _ = suite.ctx.CacheContext()
+	res3, err := suite.app.EvmKeeper.IntermediateRoots(sdk.WrapSDKContext(ctx), &types.QueryTraceBlockRequest{
+		Txs: []*types.MsgEthereumTx{firstTx},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(res.Roots[:1], res3.Roots)
+
+	// a tx which can't be replayed is reported and leaves the root unchanged
+	ctx, _ = suite.ctx.CacheContext()
+	res4, err := suite.app.EvmKeeper.IntermediateRoots(sdk.WrapSDKContext(ctx), &types.QueryTraceBlockRequest{
+		Txs: []*types.MsgEthereumTx{firstTx, buildTx(nonce + 5), secondTx},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res4.Roots, 3)
+	suite.Require().Equal(res.Roots[0], res4.Roots[0])
+	suite.Require().Equal(res.Roots[0], res4.Roots[1])
+	suite.Require().Equal(res.Roots[1], res4.Roots[2])
+	suite.Require().Empty(res4.Errors[0])
+	suite.Require().Contains(res4.Errors[1], "invalid nonce")
+	suite.Require().Empty(res4.Errors[2])
+}
+
+func (suite *KeeperTestSuite) TestTraceBlockPredecessors() {
+	suite.SetupTest()
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, sdkmath.NewIntWithDecimal(1000, 18).BigInt())
+	suite.Commit()
+	recipient := common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec")
+	firstTx := suite.TransferERC20Token(suite.T(), contractAddr, suite.address, recipient, sdkmath.NewIntWithDecimal(1, 18).BigInt())
+	secondTx := suite.TransferERC20Token(suite.T(), contractAddr, suite.address, recipient, sdkmath.NewIntWithDecimal(1, 18).BigInt())
+	suite.Commit()
+
+	ctx, _ := suite.ctx.CacheContext()
+	res, err := suite.app.EvmKeeper.TraceBlock(sdk.WrapSDKContext(ctx), &types.QueryTraceBlockRequest{
+		Txs: []*types.MsgEthereumTx{firstTx, secondTx},
+	})
+	suite.Require().NoError(err)
+	var results []*types.TxTraceResult
+	suite.Require().NoError(json.Unmarshal(res.Data, &results))
+	suite.Require().Len(results, 2)
+
+	// tracing the second tx after replaying the first one gives the same trace
+	ctx, _ = suite.ctx.CacheContext()
+	res, err = suite.app.EvmKeeper.TraceBlock(sdk.WrapSDKContext(ctx), &types.QueryTraceBlockRequest{
+		Predecessors: []*types.MsgEthereumTx{firstTx},
+		Txs:          []*types.MsgEthereumTx{secondTx},
+	})
+	suite.Require().NoError(err)
+	var chunkResults []*types.TxTraceResult
+	suite.Require().NoError(json.Unmarshal(res.Data, &chunkResults))
+	suite.Require().Equal(results[1:], chunkResults)
+}
+
+func (suite *KeeperTestSuite) TestTraceBlockState() {
+	suite.SetupTest()
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, sdkmath.NewIntWithDecimal(1000, 18).BigInt())
+	suite.Commit()
+	recipient := tests.GenerateAddress()
+	txs := make([]*types.MsgEthereumTx, 5)
+	for i := range txs {
+		txs[i] = suite.TransferERC20Token(suite.T(), contractAddr, suite.address, recipient, sdkmath.NewIntWithDecimal(1, 18).BigInt())
+	}
+	suite.Commit()
+
+	ctx, _ := suite.ctx.CacheContext()
+	res, err := suite.app.EvmKeeper.TraceBlock(sdk.WrapSDKContext(ctx), &types.QueryTraceBlockRequest{
+		Txs:         txs,
+		TraceConfig: &types.TraceConfig{},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Nil(res.State)
+	var results []*types.TxTraceResult
+	suite.Require().NoError(json.Unmarshal(res.Data, &results))
+	suite.Require().Len(results, len(txs))
+
+	for _, chunkSize := range []int{1, 2, 3} {
+		for _, parallel := range []bool{false, true} {
+			suite.Run(fmt.Sprintf("Case chunk size %d parallel %t", chunkSize, parallel), func() {
+				// each chunk is traced on a fresh context, with the state carried from the previous one
+				state := &types.TraceBlockState{}
+				var chunkResults []*types.TxTraceResult
+				for start := 0; start < len(txs); start += chunkSize {
+					end := start + chunkSize
+					if end > len(txs) {
+						end = len(txs)
+					}
+					ctx, _ := suite.ctx.CacheContext()
+					res, err := suite.app.EvmKeeper.TraceBlock(sdk.WrapSDKContext(ctx), &types.QueryTraceBlockRequest{
+						Txs:         txs[start:end],
+						TraceConfig: &types.TraceConfig{Parallel: parallel},
+						State:       state,
+					})
+					suite.Require().NoError(err)
+					var results []*types.TxTraceResult
+					suite.Require().NoError(json.Unmarshal(res.Data, &results))
+					chunkResults = append(chunkResults, results...)
+
+					suite.Require().NotNil(res.State)
+					suite.Require().Equal(uint64(end), res.State.TxIndex)
+					state = res.State
+				}
+				suite.Require().Equal(results, chunkResults)
+			})
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) TestTraceBlockParallel() {
+	suite.SetupTest()
+	// fewer workers than txs, and more txs than the cache branches stacked before a write
+	suite.app.EvmKeeper.SetMaxTraceWorkers(2)
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, sdkmath.NewIntWithDecimal(1000, 18).BigInt())
+	suite.Commit()
+	txs := make([]*types.MsgEthereumTx, 20)
+	for i := range txs {
+		txs[i] = suite.TransferERC20Token(suite.T(), contractAddr, suite.address, tests.GenerateAddress(), sdkmath.NewIntWithDecimal(1, 18).BigInt())
+	}
+	suite.Commit()
+
+	testCases := []struct {
+		name         string
+		predecessors []*types.MsgEthereumTx
+		txs          []*types.MsgEthereumTx
+		traceConfig  types.TraceConfig
+	}{
+		{"struct logger", nil, txs, types.TraceConfig{}},
+		{"call tracer", nil, txs, types.TraceConfig{Tracer: "callTracer"}},
+		{"call tracer with predecessors", txs[:1], txs[1:], types.TraceConfig{Tracer: "callTracer"}},
+		{"single tx", nil, txs[:1], types.TraceConfig{}},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			traceBlock := func(parallel bool) []byte {
+				traceConfig := tc.traceConfig
+				traceConfig.Parallel = parallel
+				ctx, _ := suite.ctx.CacheContext()
+				res, err := suite.app.EvmKeeper.TraceBlock(sdk.WrapSDKContext(ctx), &types.QueryTraceBlockRequest{
+					Predecessors: tc.predecessors,
+					Txs:          tc.txs,
+					TraceConfig:  &traceConfig,
+				})
+				suite.Require().NoError(err)
+				return res.Data
+			}
+
+			sequential := traceBlock(false)
+			var results []*types.TxTraceResult
+			suite.Require().NoError(json.Unmarshal(sequential, &results))
+			suite.Require().Len(results, len(tc.txs))
+			for _, result := range results {
+				suite.Require().Empty(result.Error)
+			}
+			suite.Require().Equal(string(sequential), string(traceBlock(true)))
+		})
+	}
+}
+
+// traceBlockStream is the server stream of a TraceBlockStream query, it fails to send the
+// result of the failAt tx if it's set.
+type traceBlockStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses []*types.QueryTraceBlockStreamResponse
+	failAt    int
+}
+
+func (s *traceBlockStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *traceBlockStream) Send(res *types.QueryTraceBlockStreamResponse) error {
+	if len(s.responses) == s.failAt {
+		return errors.New("stream closed")
+	}
+	s.responses = append(s.responses, res)
+	return nil
+}
+
+func (suite *KeeperTestSuite) TestTraceBlockStream() {
+	suite.SetupTest()
+	suite.app.EvmKeeper.SetMaxTraceWorkers(2)
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, sdkmath.NewIntWithDecimal(1000, 18).BigInt())
+	suite.Commit()
+	txs := make([]*types.MsgEthereumTx, 5)
+	for i := range txs {
+		txs[i] = suite.TransferERC20Token(suite.T(), contractAddr, suite.address, tests.GenerateAddress(), sdkmath.NewIntWithDecimal(1, 18).BigInt())
+	}
+	suite.Commit()
+
+	var queryHeight int64
+	suite.app.EvmKeeper.SetQueryContextCreator(func(height int64, _ bool) (sdk.Context, error) {
+		queryHeight = height
+		ctx, _ := suite.ctx.CacheContext()
+		return ctx, nil
+	})
+	streamCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(grpctypes.GRPCBlockHeightHeader, "7"))
+
+	testCases := []struct {
+		name         string
+		predecessors []*types.MsgEthereumTx
+		state        *types.TraceBlockState
+		parallel     bool
+		failAt       int
+		expErrMsg    string
+	}{
+		{"sequential", nil, nil, false, -1, ""},
+		{"parallel", nil, nil, true, -1, ""},
+		{"with predecessors", txs[:2], nil, true, -1, ""},
+		{"fail - state set", nil, &types.TraceBlockState{}, false, -1, "must not be set"},
+		{"fail - sequential send", nil, nil, false, 1, "stream closed"},
+		{"fail - parallel send", nil, nil, true, 1, "stream closed"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			req := &types.QueryTraceBlockRequest{
+				Predecessors: tc.predecessors,
+				Txs:          txs[len(tc.predecessors):],
+				TraceConfig:  &types.TraceConfig{Tracer: "callTracer", Parallel: tc.parallel},
+				State:        tc.state,
+			}
+			stream := &traceBlockStream{ctx: streamCtx, failAt: tc.failAt}
+			err := suite.app.EvmKeeper.TraceBlockStream(req, stream)
+			if tc.expErrMsg != "" {
+				suite.Require().ErrorContains(err, tc.expErrMsg)
+				suite.Require().LessOrEqual(len(stream.responses), 1)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(int64(7), queryHeight)
+
+			// the streamed results are the ones of the unary query
+			ctx, _ := suite.ctx.CacheContext()
+			res, err := suite.app.EvmKeeper.TraceBlock(sdk.WrapSDKContext(ctx), req)
+			suite.Require().NoError(err)
+			var results []json.RawMessage
+			suite.Require().NoError(json.Unmarshal(res.Data, &results))
+			suite.Require().Len(stream.responses, len(results))
+			for i, res := range stream.responses {
+				suite.Require().Equal(uint64(len(tc.predecessors)+i), res.TxIndex)
+				suite.Require().Equal(string(results[i]), string(res.Data))
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestNonceInQuery() {
 	address := tests.GenerateAddress()
 	suite.Require().Equal(uint64(0), suite.app.EvmKeeper.GetNonce(suite.ctx, address))
@@ -1336,6 +1780,571 @@ func (suite *KeeperTestSuite) TestEthCall() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestEthCallStateOverride() {
+	var overrides types.StateOverride
+
+	contract := tests.GenerateAddress()
+	key0 := common.BigToHash(big.NewInt(0))
+	key1 := common.BigToHash(big.NewInt(1))
+	value := common.BigToHash(big.NewInt(42))
+	// runtime code returning the storage value at the given slot:
+	// PUSH1 slot SLOAD PUSH1 0 MSTORE PUSH1 32 PUSH1 0 RETURN
+	sloadCode := func(slot byte) hexutil.Bytes {
+		return hexutil.Bytes{0x60, slot, 0x54, 0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3}
+	}
+	// runtime code returning the balance of the caller:
+	// CALLER BALANCE PUSH1 0 MSTORE PUSH1 32 PUSH1 0 RETURN
+	balanceCode := hexutil.Bytes{0x33, 0x31, 0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expRet   common.Hash
+		expPass  bool
+	}{
+		{
+			"no overrides, empty code",
+			func() {},
+			common.Hash{},
+			true,
+		},
+		{
+			"override code and state diff",
+			func() {
+				code := sloadCode(0)
+				overrides = types.StateOverride{
+					contract: {Code: &code, StateDiff: &map[common.Hash]common.Hash{key0: value}},
+				}
+			},
+			value,
+			true,
+		},
+		{
+			"state diff keeps the other committed slots",
+			func() {
+				suite.app.EvmKeeper.SetState(suite.ctx, contract, key1, value.Bytes())
+				code := sloadCode(1)
+				overrides = types.StateOverride{
+					contract: {Code: &code, StateDiff: &map[common.Hash]common.Hash{key0: value}},
+				}
+			},
+			value,
+			true,
+		},
+		{
+			"state replaces the committed storage",
+			func() {
+				suite.app.EvmKeeper.SetState(suite.ctx, contract, key1, value.Bytes())
+				code := sloadCode(1)
+				overrides = types.StateOverride{
+					contract: {Code: &code, State: &map[common.Hash]common.Hash{key0: value}},
+				}
+			},
+			common.Hash{},
+			true,
+		},
+		{
+			"override balance of the caller",
+			func() {
+				balance := (*hexutil.Big)(value.Big())
+				overrides = types.StateOverride{
+					contract:      {Code: &balanceCode},
+					suite.address: {Balance: &balance},
+				}
+			},
+			value,
+			true,
+		},
+		{
+			"fail - both state and state diff",
+			func() {
+				code := sloadCode(0)
+				overrides = types.StateOverride{
+					contract: {
+						Code:      &code,
+						State:     &map[common.Hash]common.Hash{key0: value},
+						StateDiff: &map[common.Hash]common.Hash{key0: value},
+					},
+				}
+			},
+			common.Hash{},
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			overrides = nil
+			tc.malleate()
+
+			args, err := json.Marshal(&types.TransactionArgs{From: &suite.address, To: &contract})
+			suite.Require().NoError(err)
+			req := &types.EthCallRequest{Args: args, GasCap: uint64(config.DefaultGasCap)}
+			if overrides != nil {
+				req.Overrides, err = json.Marshal(overrides)
+				suite.Require().NoError(err)
+			}
+
+			res, err := suite.queryClient.EthCall(suite.ctx, req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expRet, common.BytesToHash(res.Ret))
+
+				// overrides must not be persisted
+				suite.Require().Empty(suite.app.EvmKeeper.GetCode(suite.ctx, crypto.Keccak256Hash(sloadCode(0))))
+				suite.Require().Equal(common.Hash{}, suite.app.EvmKeeper.GetState(suite.ctx, contract, key0))
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestEthCallBlockOverrides() {
+	contract := tests.GenerateAddress()
+	coinbase := tests.GenerateAddress()
+	number := (*hexutil.Big)(big.NewInt(1000))
+	time := (*hexutil.Big)(big.NewInt(1700000000))
+	baseFee := (*hexutil.Big)(big.NewInt(12345))
+	gasLimit := hexutil.Uint64(30_000_000)
+
+	// runtime code returning the value pushed by the given opcode:
+	// OP PUSH1 0 MSTORE PUSH1 32 PUSH1 0 RETURN
+	opCode := func(op vm.OpCode) hexutil.Bytes {
+		return hexutil.Bytes{byte(op), 0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3}
+	}
+
+	testCases := []struct {
+		name      string
+		op        vm.OpCode
+		overrides *types.BlockOverrides
+		expRet    common.Hash
+	}{
+		{"number", vm.NUMBER, &types.BlockOverrides{Number: number}, common.BigToHash(number.ToInt())},
+		{"time", vm.TIMESTAMP, &types.BlockOverrides{Time: time}, common.BigToHash(time.ToInt())},
+		{"coinbase", vm.COINBASE, &types.BlockOverrides{Coinbase: &coinbase}, common.BytesToHash(coinbase.Bytes())},
+		{"base fee", vm.BASEFEE, &types.BlockOverrides{BaseFee: baseFee}, common.BigToHash(baseFee.ToInt())},
+		{"gas limit", vm.GASLIMIT, &types.BlockOverrides{GasLimit: &gasLimit}, common.BigToHash(new(big.Int).SetUint64(uint64(gasLimit)))},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			code := opCode(tc.op)
+			overrides, err := json.Marshal(types.StateOverride{contract: {Code: &code}})
+			suite.Require().NoError(err)
+			blockOverrides, err := json.Marshal(tc.overrides)
+			suite.Require().NoError(err)
+			args, err := json.Marshal(&types.TransactionArgs{From: &suite.address, To: &contract})
+			suite.Require().NoError(err)
+
+			// without block overrides the result reflects the current block
+			res, err := suite.queryClient.EthCall(suite.ctx, &types.EthCallRequest{
+				Args:      args,
+				GasCap:    uint64(config.DefaultGasCap),
+				Overrides: overrides,
+			})
+			suite.Require().NoError(err)
+			suite.Require().NotEqual(tc.expRet, common.BytesToHash(res.Ret))
+
+			res, err = suite.queryClient.EthCall(suite.ctx, &types.EthCallRequest{
+				Args:           args,
+				GasCap:         uint64(config.DefaultGasCap),
+				Overrides:      overrides,
+				BlockOverrides: blockOverrides,
+			})
+			suite.Require().NoError(err)
+			suite.Require().Empty(res.VmError)
+			suite.Require().Equal(tc.expRet, common.BytesToHash(res.Ret))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSimulateCalls() {
+	counter := tests.GenerateAddress()
+	reverter := tests.GenerateAddress()
+	number := (*hexutil.Big)(big.NewInt(1000))
+	// runtime code incrementing the value at slot 0 and returning it:
+	// PUSH1 0 SLOAD PUSH1 1 ADD DUP1 PUSH1 0 SSTORE PUSH1 0 MSTORE PUSH1 32 PUSH1 0 RETURN
+	counterCode := hexutil.Bytes{
+		0x60, 0x00, 0x54, 0x60, 0x01, 0x01, 0x80, 0x60, 0x00, 0x55,
+		0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3,
+	}
+	// runtime code reverting without data: PUSH1 0 PUSH1 0 REVERT
+	revertCode := hexutil.Bytes{0x60, 0x00, 0x60, 0x00, 0xfd}
+	counterCall := types.TransactionArgs{From: &suite.address, To: &counter}
+	// below the intrinsic gas of a call
+	lowGas := hexutil.Uint64(1000)
+
+	testCases := []struct {
+		name       string
+		blocks     []types.SimBlock
+		expRets    [][]common.Hash
+		expFailed  [][]bool
+		expNumbers []*big.Int // nil for the current block number plus the block index
+		expPass    bool
+	}{
+		{
+			"calls share the state within and across blocks",
+			[]types.SimBlock{
+				{
+					StateOverrides: &types.StateOverride{counter: {Code: &counterCode}},
+					Calls:          []types.TransactionArgs{counterCall, counterCall},
+				},
+				{
+					BlockOverrides: &types.BlockOverrides{Number: number},
+					Calls:          []types.TransactionArgs{counterCall},
+				},
+			},
+			[][]common.Hash{
+				{common.BigToHash(big.NewInt(1)), common.BigToHash(big.NewInt(2))},
+				{common.BigToHash(big.NewInt(3))},
+			},
+			[][]bool{{false, false}, {false}},
+			[]*big.Int{nil, number.ToInt()},
+			true,
+		},
+		{
+			"reverted call does not stop the following calls",
+			[]types.SimBlock{
+				{
+					StateOverrides: &types.StateOverride{
+						counter:  {Code: &counterCode},
+						reverter: {Code: &revertCode},
+					},
+					Calls: []types.TransactionArgs{
+						{From: &suite.address, To: &reverter},
+						counterCall,
+					},
+				},
+			},
+			[][]common.Hash{{{}, common.BigToHash(big.NewInt(1))}},
+			[][]bool{{true, false}},
+			[]*big.Int{nil},
+			true,
+		},
+		{
+			"call which can't be executed is reported in its result",
+			[]types.SimBlock{
+				{
+					StateOverrides: &types.StateOverride{counter: {Code: &counterCode}},
+					Calls: []types.TransactionArgs{
+						{From: &suite.address, To: &counter, Gas: &lowGas},
+						counterCall,
+					},
+				},
+			},
+			[][]common.Hash{{{}, common.BigToHash(big.NewInt(1))}},
+			[][]bool{{true, false}},
+			[]*big.Int{nil},
+			true,
+		},
+		{
+			"fail - invalid state overrides",
+			[]types.SimBlock{
+				{
+					StateOverrides: &types.StateOverride{
+						counter: {
+							Code:      &counterCode,
+							State:     &map[common.Hash]common.Hash{},
+							StateDiff: &map[common.Hash]common.Hash{},
+						},
+					},
+					Calls: []types.TransactionArgs{counterCall},
+				},
+			},
+			nil,
+			nil,
+			nil,
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			blocks, err := json.Marshal(tc.blocks)
+			suite.Require().NoError(err)
+			res, err := suite.queryClient.SimulateCalls(suite.ctx, &types.QuerySimulateCallsRequest{
+				Blocks: blocks,
+				GasCap: uint64(config.DefaultGasCap),
+			})
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			var results []types.SimBlockResult
+			suite.Require().NoError(json.Unmarshal(res.Data, &results))
+			suite.Require().Len(results, len(tc.expRets))
+			for i, result := range results {
+				expNumber := tc.expNumbers[i]
+				if expNumber == nil {
+					expNumber = big.NewInt(suite.ctx.BlockHeight() + int64(i))
+				}
+				suite.Require().Equal(expNumber, result.Number.ToInt())
+				suite.Require().Len(result.Calls, len(tc.expRets[i]))
+				var gasUsed hexutil.Uint64
+				for j, call := range result.Calls {
+					gasUsed += call.GasUsed
+					if tc.expFailed[i][j] {
+						suite.Require().Equal(hexutil.Uint64(ethtypes.ReceiptStatusFailed), call.Status)
+						suite.Require().NotNil(call.Error)
+						continue
+					}
+					suite.Require().Equal(hexutil.Uint64(ethtypes.ReceiptStatusSuccessful), call.Status)
+					suite.Require().Nil(call.Error)
+					suite.Require().Equal(tc.expRets[i][j], common.BytesToHash(call.ReturnValue))
+				}
+				suite.Require().Equal(gasUsed, result.GasUsed)
+			}
+
+			// nothing is persisted
+			suite.Require().Empty(suite.app.EvmKeeper.GetCode(suite.ctx, common.BytesToHash(crypto.Keccak256(counterCode))))
+			suite.Require().Equal(common.Hash{}, suite.app.EvmKeeper.GetState(suite.ctx, counter, common.Hash{}))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSimulateCallsBlocks() {
+	clock := tests.GenerateAddress()
+	// runtime code returning the block number and time:
+	// NUMBER PUSH1 0 MSTORE TIMESTAMP PUSH1 32 MSTORE PUSH1 64 PUSH1 0 RETURN
+	clockCode := hexutil.Bytes{0x43, 0x60, 0x00, 0x52, 0x42, 0x60, 0x20, 0x52, 0x60, 0x40, 0x60, 0x00, 0xf3}
+	clockCall := types.TransactionArgs{From: &suite.address, To: &clock}
+	overrides := &types.StateOverride{clock: {Code: &clockCode}}
+
+	simulate := func(blocks []types.SimBlock, gasCap uint64) ([]types.SimBlockResult, error) {
+		bz, err := json.Marshal(blocks)
+		suite.Require().NoError(err)
+		res, err := suite.queryClient.SimulateCalls(suite.ctx, &types.QuerySimulateCallsRequest{Blocks: bz, GasCap: gasCap})
+		if err != nil {
+			return nil, err
+		}
+		var results []types.SimBlockResult
+		suite.Require().NoError(json.Unmarshal(res.Data, &results))
+		return results, nil
+	}
+
+	suite.Run("blocks advance the number and the time", func() {
+		suite.SetupTest()
+		number := big.NewInt(1000)
+		results, err := simulate([]types.SimBlock{
+			{StateOverrides: overrides, Calls: []types.TransactionArgs{clockCall}},
+			{Calls: []types.TransactionArgs{clockCall}},
+			{BlockOverrides: &types.BlockOverrides{Number: (*hexutil.Big)(number)}, Calls: []types.TransactionArgs{clockCall}},
+			{Calls: []types.TransactionArgs{clockCall}},
+		}, uint64(config.DefaultGasCap))
+		suite.Require().NoError(err)
+
+		height := suite.ctx.BlockHeight()
+		time := suite.ctx.BlockHeader().Time.Unix()
+		expNumbers := []int64{height, height + 1, number.Int64(), number.Int64() + 1}
+		for i, result := range results {
+			expTime := time + int64(i)*types.SimBlockTimeIncrement
+			suite.Require().Equal(big.NewInt(expNumbers[i]), result.Number.ToInt())
+			suite.Require().Equal(hexutil.Uint64(expTime), result.Timestamp)
+			// the block context of the calls is the simulated block
+			ret := result.Calls[0].ReturnValue
+			suite.Require().Equal(big.NewInt(expNumbers[i]), new(big.Int).SetBytes(ret[:32]))
+			suite.Require().Equal(big.NewInt(expTime), new(big.Int).SetBytes(ret[32:]))
+		}
+	})
+
+	suite.Run("fail - block numbers out of order", func() {
+		suite.SetupTest()
+		_, err := simulate([]types.SimBlock{
+			{BlockOverrides: &types.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(1000))}},
+			{BlockOverrides: &types.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(1000))}},
+		}, uint64(config.DefaultGasCap))
+		suite.Require().ErrorContains(err, "is not greater than the previous one")
+	})
+
+	suite.Run("calls are checked against the given nonces", func() {
+		suite.SetupTest()
+		nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+		// the first call increases the nonce of the sender
+		second, stale := clockCall, clockCall
+		nextNonce := nonce + 1
+		second.Nonce = (*hexutil.Uint64)(&nextNonce)
+		stale.Nonce = (*hexutil.Uint64)(&nonce)
+		results, err := simulate([]types.SimBlock{
+			{StateOverrides: overrides, Calls: []types.TransactionArgs{clockCall, second, stale}},
+		}, uint64(config.DefaultGasCap))
+		suite.Require().NoError(err)
+		suite.Require().Nil(results[0].Calls[0].Error)
+		suite.Require().Nil(results[0].Calls[1].Error)
+		suite.Require().Contains(results[0].Calls[2].Error.Message, "nonce too low")
+	})
+
+	suite.Run("fail - too many blocks", func() {
+		suite.SetupTest()
+		_, err := simulate(make([]types.SimBlock, 257), uint64(config.DefaultGasCap))
+		suite.Require().ErrorContains(err, "too many blocks")
+	})
+
+	suite.Run("fail - too many calls", func() {
+		suite.SetupTest()
+		_, err := simulate([]types.SimBlock{
+			{Calls: make([]types.TransactionArgs, 600)},
+			{Calls: make([]types.TransactionArgs, 401)},
+		}, uint64(config.DefaultGasCap))
+		suite.Require().ErrorContains(err, "too many calls")
+	})
+
+	suite.Run("calls share the gas cap", func() {
+		suite.SetupTest()
+		// the calls are charged the gas they actually use
+		params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+		params.MinGasMultiplier = sdk.ZeroDec()
+		suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(suite.ctx, params))
+
+		results, err := simulate([]types.SimBlock{
+			{StateOverrides: overrides, Calls: []types.TransactionArgs{clockCall}},
+		}, uint64(config.DefaultGasCap))
+		suite.Require().NoError(err)
+		gasUsed := uint64(results[0].GasUsed)
+
+		// the budget of the third call is below its intrinsic gas
+		results, err = simulate([]types.SimBlock{
+			{StateOverrides: overrides, Calls: []types.TransactionArgs{clockCall}},
+			{Calls: []types.TransactionArgs{clockCall, clockCall}},
+		}, 2*gasUsed+1000)
+		suite.Require().NoError(err)
+		suite.Require().Nil(results[0].Calls[0].Error)
+		suite.Require().Nil(results[1].Calls[0].Error)
+		suite.Require().NotNil(results[1].Calls[1].Error)
+
+		// no call is executed once the budget is exhausted
+		results, err = simulate([]types.SimBlock{
+			{StateOverrides: overrides, Calls: []types.TransactionArgs{clockCall, clockCall}},
+		}, gasUsed)
+		suite.Require().NoError(err)
+		suite.Require().Nil(results[0].Calls[0].Error)
+		suite.Require().Contains(results[0].Calls[1].Error.Message, "gas cap of the simulation exhausted")
+	})
+}
+
+func (suite *KeeperTestSuite) TestSimulateCallsStateOverride() {
+	suite.SetupTest()
+
+	contract := tests.GenerateAddress()
+	key := common.BigToHash(big.NewInt(1))
+	// runtime code returning the storage value at slot 1:
+	// PUSH1 1 SLOAD PUSH1 0 MSTORE PUSH1 32 PUSH1 0 RETURN
+	sloadCode := []byte{0x60, 0x01, 0x54, 0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3}
+	codeHash := crypto.Keccak256Hash(sloadCode)
+	suite.app.EvmKeeper.SetCode(suite.ctx, codeHash.Bytes(), sloadCode)
+	suite.Require().NoError(suite.app.EvmKeeper.SetAccount(suite.ctx, contract, statedb.Account{
+		Balance:  big.NewInt(0),
+		CodeHash: codeHash.Bytes(),
+	}))
+	suite.app.EvmKeeper.SetState(suite.ctx, contract, key, common.BigToHash(big.NewInt(1)).Bytes())
+	suite.Commit()
+
+	// only the storage of the existing contract is overridden
+	state := map[common.Hash]common.Hash{key: common.BigToHash(big.NewInt(2))}
+	blocks, err := json.Marshal([]types.SimBlock{{
+		StateOverrides: &types.StateOverride{contract: {State: &state}},
+		Calls:          []types.TransactionArgs{{From: &suite.address, To: &contract}},
+	}})
+	suite.Require().NoError(err)
+	res, err := suite.queryClient.SimulateCalls(suite.ctx, &types.QuerySimulateCallsRequest{
+		Blocks: blocks,
+		GasCap: uint64(config.DefaultGasCap),
+	})
+	suite.Require().NoError(err)
+
+	var results []types.SimBlockResult
+	suite.Require().NoError(json.Unmarshal(res.Data, &results))
+	suite.Require().Len(results, 1)
+	suite.Require().Len(results[0].Calls, 1)
+	suite.Require().Nil(results[0].Calls[0].Error)
+	suite.Require().Equal(common.BigToHash(big.NewInt(2)), common.BytesToHash(results[0].Calls[0].ReturnValue))
+
+	// nothing is persisted
+	suite.Require().Equal(common.BigToHash(big.NewInt(1)), suite.app.EvmKeeper.GetState(suite.ctx, contract, key))
+}
+
+func (suite *KeeperTestSuite) TestCreateAccessList() {
+	var req *types.EthCallRequest
+
+	contract := tests.GenerateAddress()
+	key := common.BigToHash(big.NewInt(1))
+	// runtime code returning the storage value at slot 1:
+	// PUSH1 1 SLOAD PUSH1 0 MSTORE PUSH1 32 PUSH1 0 RETURN
+	sloadCode := hexutil.Bytes{0x60, 0x01, 0x54, 0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3}
+	// runtime code calling the ecrecover precompile:
+	// PUSH1 0 DUP1 DUP1 DUP1 DUP1 PUSH1 1 GAS CALL
+	precompileCode := hexutil.Bytes{0x60, 0x00, 0x80, 0x80, 0x80, 0x80, 0x60, 0x01, 0x5a, 0xf1}
+
+	newRequest := func(code hexutil.Bytes) *types.EthCallRequest {
+		args, err := json.Marshal(&types.TransactionArgs{From: &suite.address, To: &contract})
+		suite.Require().NoError(err)
+		overrides, err := json.Marshal(types.StateOverride{contract: {Code: &code}})
+		suite.Require().NoError(err)
+		return &types.EthCallRequest{Args: args, GasCap: uint64(config.DefaultGasCap), Overrides: overrides}
+	}
+
+	testCases := []struct {
+		name          string
+		malleate      func()
+		expAccessList ethtypes.AccessList
+		expPass       bool
+	}{
+		{
+			"invalid args",
+			func() {
+				req = &types.EthCallRequest{Args: []byte("invalid args"), GasCap: uint64(config.DefaultGasCap)}
+			},
+			nil,
+			false,
+		},
+		{
+			"storage slot read by the call",
+			func() {
+				req = newRequest(sloadCode)
+			},
+			ethtypes.AccessList{{Address: contract, StorageKeys: []common.Hash{key}}},
+			true,
+		},
+		{
+			"precompiles are excluded",
+			func() {
+				req = newRequest(precompileCode)
+			},
+			nil,
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			res, err := suite.queryClient.CreateAccessList(suite.ctx, req)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Empty(res.VmError)
+			suite.Require().Equal(tc.expAccessList, *res.AccessList.ToEthAccessList())
+
+			// the gas used matches a call including the access list
+			var args types.TransactionArgs
+			suite.Require().NoError(json.Unmarshal(req.Args, &args))
+			args.AccessList = res.AccessList.ToEthAccessList()
+			req.Args, err = json.Marshal(&args)
+			suite.Require().NoError(err)
+			callRes, err := suite.queryClient.EthCall(suite.ctx, req)
+			suite.Require().NoError(err)
+			suite.Require().Equal(callRes.GasUsed, res.GasUsed)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestEmptyRequest() {
 	k := suite.app.EvmKeeper
 
@@ -1391,18 +2400,42 @@ func (suite *KeeperTestSuite) TestEmptyRequest() {
 				return k.EstimateGas(suite.ctx, nil)
 			},
 		},
+		{
+			"SimulateCalls method",
+			func() (interface{}, error) {
+				return k.SimulateCalls(suite.ctx, nil)
+			},
+		},
+		{
+			"CreateAccessList method",
+			func() (interface{}, error) {
+				return k.CreateAccessList(suite.ctx, nil)
+			},
+		},
 		{
 			"TraceTx method",
 			func() (interface{}, error) {
 				return k.TraceTx(suite.ctx, nil)
 			},
 		},
+		{
+			"TraceCall method",
+			func() (interface{}, error) {
+				return k.TraceCall(suite.ctx, nil)
+			},
+		},
 		{
 			"TraceBlock method",
 			func() (interface{}, error) {
 				return k.TraceBlock(suite.ctx, nil)
 			},
 		},
+		{
+			"IntermediateRoots method",
+			func() (interface{}, error) {
+				return k.IntermediateRoots(suite.ctx, nil)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1413,3 +2446,204 @@ func (suite *KeeperTestSuite) TestEmptyRequest() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestStorageRangeAt() {
+	suite.SetupTest()
+	keys := []common.Hash{common.BigToHash(big.NewInt(1)), common.BigToHash(big.NewInt(2)), common.BigToHash(big.NewInt(3))}
+	vmdb := suite.StateDB()
+	for i, key := range keys {
+		vmdb.SetState(suite.address, key, common.BigToHash(big.NewInt(int64(i+10))))
+	}
+	suite.Require().NoError(vmdb.Commit())
+
+	testCases := []struct {
+		msg        string
+		req        *types.QueryStorageRangeAtRequest
+		expPass    bool
+		expKeys    []common.Hash
+		expNextKey []byte
+	}{
+		{
+			"fail - empty request",
+			nil,
+			false,
+			nil,
+			nil,
+		},
+		{
+			"fail - invalid address",
+			&types.QueryStorageRangeAtRequest{Address: invalidAddress, MaxResults: 10},
+			false,
+			nil,
+			nil,
+		},
+		{
+			"pass - all entries",
+			&types.QueryStorageRangeAtRequest{Address: suite.address.Hex(), MaxResults: 10},
+			true,
+			keys,
+			nil,
+		},
+		{
+			"pass - paginated",
+			&types.QueryStorageRangeAtRequest{Address: suite.address.Hex(), MaxResults: 2},
+			true,
+			keys[:2],
+			keys[2].Bytes(),
+		},
+		{
+			"pass - from start key",
+			&types.QueryStorageRangeAtRequest{Address: suite.address.Hex(), StartKey: []byte{2}, MaxResults: 10},
+			true,
+			keys[1:],
+			nil,
+		},
+		{
+			"pass - no storage",
+			&types.QueryStorageRangeAtRequest{Address: tests.GenerateAddress().Hex(), MaxResults: 10},
+			true,
+			[]common.Hash{},
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			if tc.req != nil {
+				tc.req.ChainId = suite.app.EvmKeeper.ChainID().Int64()
+			}
+			res, err := suite.app.EvmKeeper.StorageRangeAt(sdk.WrapSDKContext(suite.ctx), tc.req)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Len(res.Storage, len(tc.expKeys))
+			for i, key := range tc.expKeys {
+				suite.Require().Equal(key.Hex(), res.Storage[i].Key)
+			}
+			suite.Require().Equal(tc.expNextKey, res.NextKey)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestStorageRangeAtPredecessors() {
+	suite.SetupTest()
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, sdkmath.NewIntWithDecimal(1000, 18).BigInt())
+	suite.Commit()
+	recipient := tests.GenerateAddress()
+	transferTx := suite.TransferERC20Token(suite.T(), contractAddr, suite.address, recipient, sdkmath.NewIntWithDecimal(1, 18).BigInt())
+	suite.Commit()
+
+	req := &types.QueryStorageRangeAtRequest{
+		Address:    contractAddr.Hex(),
+		MaxResults: 100,
+		ChainId:    suite.app.EvmKeeper.ChainID().Int64(),
+	}
+	ctx, _ := suite.ctx.CacheContext()
+	res, err := suite.app.EvmKeeper.StorageRangeAt(sdk.WrapSDKContext(ctx), req)
+	suite.Require().NoError(err)
+	suite.Require().NotEmpty(res.Storage)
+
+	// replaying the transfer again updates the balances of the sender and the recipient
+	req.Predecessors = []*types.MsgEthereumTx{transferTx}
+	ctx, _ = suite.ctx.CacheContext()
+	res2, err := suite.app.EvmKeeper.StorageRangeAt(sdk.WrapSDKContext(ctx), req)
+	suite.Require().NoError(err)
+	suite.Require().Len(res2.Storage, len(res.Storage))
+	suite.Require().NotEqual(res.Storage, res2.Storage)
+
+	// the replay isn't persisted
+	res3, err := suite.app.EvmKeeper.StorageRangeAt(sdk.WrapSDKContext(suite.ctx), &types.QueryStorageRangeAtRequest{
+		Address:    contractAddr.Hex(),
+		MaxResults: 100,
+		ChainId:    suite.app.EvmKeeper.ChainID().Int64(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(res.Storage, res3.Storage)
+}
+
+func (suite *KeeperTestSuite) TestAccountRange() {
+	suite.SetupTest()
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, sdkmath.NewIntWithDecimal(1000, 18).BigInt())
+	suite.Commit()
+
+	// the accounts are ordered by address
+	first, second := suite.address, contractAddr
+	if bytes.Compare(first.Bytes(), second.Bytes()) > 0 {
+		first, second = second, first
+	}
+
+	res, err := suite.app.EvmKeeper.AccountRange(sdk.WrapSDKContext(suite.ctx), nil)
+	suite.Require().Error(err)
+	suite.Require().Nil(res)
+
+	res, err = suite.queryClient.AccountRange(sdk.WrapSDKContext(suite.ctx), &types.QueryAccountRangeRequest{
+		Start:      first.Bytes(),
+		MaxResults: 1,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Accounts, 1)
+	suite.Require().Equal(first.Hex(), res.Accounts[0].Address)
+	suite.Require().NotEmpty(res.Next)
+
+	res, err = suite.queryClient.AccountRange(sdk.WrapSDKContext(suite.ctx), &types.QueryAccountRangeRequest{
+		Start:      res.Next,
+		MaxResults: 1000,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Next)
+
+	var found bool
+	for _, acc := range res.Accounts {
+		suite.Require().True(bytes.Compare(common.HexToAddress(acc.Address).Bytes(), first.Bytes()) > 0)
+		if acc.Address != contractAddr.Hex() {
+			continue
+		}
+		found = true
+		suite.Require().Equal(suite.app.EvmKeeper.GetNonce(suite.ctx, contractAddr), acc.Nonce)
+		suite.Require().Equal(suite.app.EvmKeeper.GetCode(suite.ctx, common.BytesToHash(acc.CodeHash)), acc.Code)
+		suite.Require().NotEmpty(acc.Code)
+		suite.Require().NotEmpty(acc.Storage)
+	}
+	suite.Require().Equal(contractAddr == second, found)
+
+	// paging one account at a time returns the same accounts as a single page
+	all, err := suite.queryClient.AccountRange(sdk.WrapSDKContext(suite.ctx), &types.QueryAccountRangeRequest{
+		MaxResults: 1000,
+		NoStorage:  true,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Empty(all.Next)
+
+	var paged []types.AccountDump
+	var next []byte
+	for {
+		res, err = suite.queryClient.AccountRange(sdk.WrapSDKContext(suite.ctx), &types.QueryAccountRangeRequest{
+			Start:      next,
+			MaxResults: 1,
+			NoStorage:  true,
+		})
+		suite.Require().NoError(err)
+		paged = append(paged, res.Accounts...)
+		if len(res.Next) == 0 {
+			break
+		}
+		next = res.Next
+	}
+	suite.Require().Equal(all.Accounts, paged)
+
+	// skip the code and the storage
+	res, err = suite.queryClient.AccountRange(sdk.WrapSDKContext(suite.ctx), &types.QueryAccountRangeRequest{
+		Start:      contractAddr.Bytes(),
+		MaxResults: 1,
+		NoCode:     true,
+		NoStorage:  true,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Accounts, 1)
+	suite.Require().Equal(contractAddr.Hex(), res.Accounts[0].Address)
+	suite.Require().NotEmpty(res.Accounts[0].CodeHash)
+	suite.Require().Empty(res.Accounts[0].Code)
+	suite.Require().Empty(res.Accounts[0].Storage)
+}
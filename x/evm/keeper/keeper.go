@@ -17,6 +17,7 @@ package keeper
 
 import (
 	"math/big"
+	"runtime"
 
 	errorsmod "cosmossdk.io/errors"
 	"github.com/cometbft/cometbft/libs/log"
@@ -68,6 +69,12 @@ type Keeper struct {
 	// Tracer used to collect execution traces from the EVM transaction execution
 	tracer string
 
+	// traceWorkers bounds the number of txs traced in parallel by all the trace block queries
+	traceWorkers chan struct{}
+	// queryContext creates the context of the streaming queries, which aren't given one by
+	// the gRPC server
+	queryContext func(height int64, prove bool) (sdk.Context, error)
+
 	// EVM Hooks for tx post-processing
 	hooks types.EvmHooks
 
@@ -116,6 +123,7 @@ func NewKeeper(
 		transientKey:    transientKey,
 		evmConstructor:  evmConstructor,
 		tracer:          tracer,
+		traceWorkers:    make(chan struct{}, runtime.NumCPU()),
 		ss:              ss,
 	}
 }
@@ -258,6 +266,22 @@ func (k *Keeper) SetHooks(eh types.EvmHooks) *Keeper {
 	return k
 }
 
+// SetMaxTraceWorkers sets the max number of txs traced in parallel by the node, it keeps
+// the number of CPUs if max is not positive. It should be called only during initialization.
+func (k *Keeper) SetMaxTraceWorkers(max int) *Keeper {
+	if max > 0 {
+		k.traceWorkers = make(chan struct{}, max)
+	}
+	return k
+}
+
+// SetQueryContextCreator sets the function creating the context of the streaming queries at
+// a height, it's `BaseApp.CreateQueryContext`. It should be called only during initialization.
+func (k *Keeper) SetQueryContextCreator(fn func(height int64, prove bool) (sdk.Context, error)) *Keeper {
+	k.queryContext = fn
+	return k
+}
+
 // PostTxProcessing delegate the call to the hooks. If no hook has been registered, this function returns with a `nil` error
 func (k *Keeper) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
 	if k.hooks == nil {
@@ -50,6 +50,18 @@ func (k *Keeper) NewEVM(
 	tracer vm.EVMLogger,
 	stateDB vm.StateDB,
 ) *vm.EVM {
+	blockCtx := k.NewBlockContext(ctx, cfg)
+	txCtx := core.NewEVMTxContext(msg)
+	if tracer == nil {
+		tracer = k.Tracer(ctx, msg, cfg.ChainConfig)
+	}
+	vmConfig := k.VMConfig(ctx, msg, cfg, tracer)
+	return k.evmConstructor(blockCtx, txCtx, stateDB, cfg.ChainConfig, vmConfig)
+}
+
+// NewBlockContext returns the EVM block context of the current block, the
+// block overrides of the config are applied to it.
+func (k Keeper) NewBlockContext(ctx sdk.Context, cfg *statedb.EVMConfig) vm.BlockContext {
 	blockCtx := vm.BlockContext{
 		CanTransfer: core.CanTransfer,
 		Transfer:    core.Transfer,
@@ -62,13 +74,8 @@ func (k *Keeper) NewEVM(
 		BaseFee:     cfg.BaseFee,
 		Random:      nil, // not supported
 	}
-
-	txCtx := core.NewEVMTxContext(msg)
-	if tracer == nil {
-		tracer = k.Tracer(ctx, msg, cfg.ChainConfig)
-	}
-	vmConfig := k.VMConfig(ctx, msg, cfg, tracer)
-	return k.evmConstructor(blockCtx, txCtx, stateDB, cfg.ChainConfig, vmConfig)
+	cfg.BlockOverrides.Apply(&blockCtx)
+	return blockCtx
 }
 
 // GetHashFn implements vm.GetHashFunc for Ethermint. It handles 3 cases:
@@ -205,7 +212,7 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 
 	receipt := &ethtypes.Receipt{
 		Type:              tx.Type(),
-		PostState:         nil, // TODO: intermediate state root
+		PostState:         nil, // intermediate state roots are re-computed by `debug_intermediateRoots`
 		CumulativeGasUsed: cumulativeGasUsed,
 		Bloom:             bloomReceipt,
 		Logs:              logs,
@@ -280,10 +287,18 @@ func (k *Keeper) ApplyMessage(ctx sdk.Context, msg core.Message, tracer vm.EVMLo
 //
 // # Different Callers
 //
-// It's called in three scenarios:
+// It's called in four scenarios:
 // 1. `ApplyTransaction`, in the transaction processing flow.
 // 2. `EthCall/EthEstimateGas` grpc query handler.
-// 3. Called by other native modules directly.
+// 3. `SimulateCalls` grpc query handler, which commits every call to a branched context.
+// 4. Called by other native modules directly.
+//
+// # State overrides
+//
+// If `cfg.Overrides` is set, it's applied to the `StateDB` before executing the message.
+// When combined with commit, the overridden accounts are persisted together with the
+// changes of the message, an overridden `state` replaces the whole storage of the account
+// in the store (see `StateDB.Commit`).
 //
 // # Prechecks and Preprocessing
 //
@@ -328,6 +343,10 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 	}
 
 	stateDB := statedb.New(ctx, k, txConfig)
+	// apply the state overrides of the call before executing it
+	if err := cfg.Overrides.Apply(stateDB); err != nil {
+		return nil, err
+	}
 	evm := k.NewEVM(ctx, msg, cfg, tracer, stateDB)
 
 	leftoverGas := msg.Gas()
@@ -360,7 +379,7 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 
 	// access list preparation is moved from ante handler to here, because it's needed when `ApplyMessage` is called
 	// under contexts where ante handlers are not run, for example `eth_call` and `eth_estimateGas`.
-	if rules := cfg.ChainConfig.Rules(big.NewInt(ctx.BlockHeight()), cfg.ChainConfig.MergeNetsplitBlock != nil); rules.IsBerlin {
+	if rules := cfg.ChainConfig.Rules(evm.Context.BlockNumber, cfg.ChainConfig.MergeNetsplitBlock != nil); rules.IsBerlin {
 		stateDB.PrepareAccessList(msg.From(), msg.To(), vm.ActivePrecompiles(rules), msg.AccessList())
 	}
 
@@ -401,6 +420,12 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 		if err := stateDB.Commit(); err != nil {
 			return nil, errorsmod.Wrap(err, "failed to commit stateDB")
 		}
+		if cfg.IntermediateRoot != nil {
+			*cfg.IntermediateRoot = crypto.Keccak256Hash(cfg.IntermediateRoot.Bytes(), stateDB.Commitment().Bytes())
+		}
+		if cfg.StateDiff != nil {
+			stateDB.CollectDiff(cfg.StateDiff)
+		}
 	}
 
 	// calculate a minimum amount of gas to be charged to sender if GasLimit
@@ -59,4 +59,16 @@ type EVMConfig struct {
 	ChainConfig *params.ChainConfig
 	CoinBase    common.Address
 	BaseFee     *big.Int
+	// Overrides is applied to the `StateDB` before executing the message, it's set by the
+	// `EthCall`, `EstimateGas`, `CreateAccessList` and `TraceCall` queries.
+	Overrides *types.StateOverride
+	// BlockOverrides is applied to the EVM block context, it's set by the same queries and
+	// per simulated block by the `SimulateCalls` query.
+	BlockOverrides *types.BlockOverrides
+	// IntermediateRoot is chained with the state commitment of each committed message,
+	// it's only set by the `debug_intermediateRoots` query handler.
+	IntermediateRoot *common.Hash
+	// StateDiff collects the accounts written by each committed message, it's only set by
+	// the `debug_traceBlock*` query handler when a block is traced in chunks.
+	StateDiff StateDiff
 }
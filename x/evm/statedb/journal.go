@@ -124,6 +124,10 @@ type (
 		account            *common.Address
 		prevcode, prevhash []byte
 	}
+	fakeStorageChange struct {
+		account *common.Address
+		prev    Storage
+	}
 
 	// Changes to other state values.
 	refundChange struct {
@@ -201,6 +205,14 @@ func (ch storageChange) Dirtied() *common.Address {
 	return ch.account
 }
 
+func (ch fakeStorageChange) Revert(s *StateDB) {
+	s.getStateObject(*ch.account).fakeStorage = ch.prev
+}
+
+func (ch fakeStorageChange) Dirtied() *common.Address {
+	return ch.account
+}
+
 func (ch refundChange) Revert(s *StateDB) {
 	s.refund = ch.prev
 }
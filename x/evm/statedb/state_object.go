@@ -64,6 +64,18 @@ func (s Storage) SortedKeys() []common.Hash {
 	return keys
 }
 
+// Copy returns a copy of the storage, nil if the storage is nil
+func (s Storage) Copy() Storage {
+	if s == nil {
+		return nil
+	}
+	cpy := make(Storage, len(s))
+	for key, value := range s {
+		cpy[key] = value
+	}
+	return cpy
+}
+
 // stateObject is the state of an acount
 type stateObject struct {
 	db *StateDB
@@ -74,6 +86,8 @@ type stateObject struct {
 	// state storage
 	originStorage Storage
 	dirtyStorage  Storage
+	// fakeStorage replaces the committed storage when the state is overridden
+	fakeStorage Storage
 
 	address common.Address
 
@@ -214,6 +228,10 @@ func (s *stateObject) Nonce() uint64 {
 
 // GetCommittedState query the committed state
 func (s *stateObject) GetCommittedState(key common.Hash) common.Hash {
+	// If the fake storage is set, only lookup the state here(in the debugging mode)
+	if s.fakeStorage != nil {
+		return s.fakeStorage[key]
+	}
 	if value, cached := s.originStorage[key]; cached {
 		return value
 	}
@@ -250,3 +268,24 @@ func (s *stateObject) SetState(key common.Hash, value common.Hash) {
 func (s *stateObject) setState(key, value common.Hash) {
 	s.dirtyStorage[key] = value
 }
+
+// SetStorage replaces the entire state storage with the given one.
+//
+// After this function is called, all original state will be ignored and state
+// lookup only happens in the fake state storage.
+//
+// Note this function should only be used for debugging purpose.
+func (s *stateObject) SetStorage(storage map[common.Hash]common.Hash) {
+	// Journal the change so that the account is dirty and the storage is committed
+	s.db.journal.append(fakeStorageChange{
+		account: &s.address,
+		prev:    s.fakeStorage.Copy(),
+	})
+	// Allocate fake storage if it's nil.
+	if s.fakeStorage == nil {
+		s.fakeStorage = make(Storage)
+	}
+	for key, value := range storage {
+		s.fakeStorage[key] = value
+	}
+}
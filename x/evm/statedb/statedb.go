@@ -282,6 +282,17 @@ func (s *StateDB) ForEachStorage(addr common.Address, cb func(key, value common.
 	if so == nil {
 		return nil
 	}
+	if so.fakeStorage != nil {
+		for key, value := range so.fakeStorage {
+			if dirtyValue, dirty := so.dirtyStorage[key]; dirty {
+				value = dirtyValue
+			}
+			if !cb(key, value) {
+				break
+			}
+		}
+		return nil
+	}
 	s.keeper.ForEachStorage(s.ctx, addr, func(key, value common.Hash) bool {
 		if value, dirty := so.dirtyStorage[key]; dirty {
 			return cb(key, value)
@@ -318,6 +329,14 @@ func (s *StateDB) SubBalance(addr common.Address, amount *big.Int) {
 	}
 }
 
+// SetBalance sets the balance of account.
+func (s *StateDB) SetBalance(addr common.Address, amount *big.Int) {
+	stateObject := s.getOrNewStateObject(addr)
+	if stateObject != nil {
+		stateObject.SetBalance(amount)
+	}
+}
+
 // SetNonce sets the nonce of account.
 func (s *StateDB) SetNonce(addr common.Address, nonce uint64) {
 	stateObject := s.getOrNewStateObject(addr)
@@ -342,6 +361,16 @@ func (s *StateDB) SetState(addr common.Address, key, value common.Hash) {
 	}
 }
 
+// SetStorage replaces the entire storage for the specified account with given
+// storage. This function should only be used for debugging and the mutations
+// must be discarded afterwards.
+func (s *StateDB) SetStorage(addr common.Address, storage map[common.Hash]common.Hash) {
+	stateObject := s.getOrNewStateObject(addr)
+	if stateObject != nil {
+		stateObject.SetStorage(storage)
+	}
+}
+
 // Suicide marks the given account as suicided.
 // This clears the account balance.
 //
@@ -464,6 +493,10 @@ func (s *StateDB) Commit() error {
 			if err := s.keeper.SetAccount(s.ctx, obj.Address(), obj.account); err != nil {
 				return errorsmod.Wrap(err, "failed to set account")
 			}
+			if obj.fakeStorage != nil {
+				s.commitFakeStorage(obj)
+				continue
+			}
 			for _, key := range obj.dirtyStorage.SortedKeys() {
 				value := obj.dirtyStorage[key]
 				// Skip noop changes, persist actual changes
@@ -476,3 +509,126 @@ func (s *StateDB) Commit() error {
 	}
 	return nil
 }
+
+// Commitment returns a deterministic hash of the dirty accounts and storage slots
+// written by `Commit`, it's used to detect at which transaction the states of two
+// nodes diverge.
+func (s *StateDB) Commitment() common.Hash {
+	hasher := crypto.NewKeccakState()
+	for _, addr := range s.journal.sortedDirties() {
+		obj := s.stateObjects[addr]
+		hasher.Write(addr.Bytes())
+		if obj.suicided {
+			hasher.Write([]byte{0})
+			continue
+		}
+		hasher.Write([]byte{1})
+		hasher.Write(sdk.Uint64ToBigEndian(obj.account.Nonce))
+		hasher.Write(common.LeftPadBytes(obj.account.Balance.Bytes(), 32))
+		hasher.Write(obj.CodeHash())
+
+		storage := obj.dirtyStorage
+		if obj.fakeStorage != nil {
+			storage = make(Storage, len(obj.fakeStorage)+len(obj.dirtyStorage))
+			for key, value := range obj.fakeStorage {
+				storage[key] = value
+			}
+			for key, value := range obj.dirtyStorage {
+				storage[key] = value
+			}
+		}
+		for _, key := range storage.SortedKeys() {
+			value := storage[key]
+			// Skip noop changes like `Commit` does
+			if obj.fakeStorage == nil && value == obj.originStorage[key] {
+				continue
+			}
+			hasher.Write(key.Bytes())
+			hasher.Write(value.Bytes())
+		}
+	}
+
+	var commitment common.Hash
+	hasher.Read(commitment[:]) //nolint: errcheck
+	return commitment
+}
+
+// StateDiff accumulates the accounts written by committed state DBs, it's used to carry
+// the state written by the transactions of a block across the queries tracing the block
+// in chunks.
+type StateDiff map[common.Address]*AccountDiff
+
+// AccountDiff is the state of an account written by committed state DBs
+type AccountDiff struct {
+	// Deleted is true if the account is self destructed, the account is deleted before
+	// the other fields are written.
+	Deleted bool
+	// Exists is false if the account doesn't exist after the writes
+	Exists  bool
+	Account Account
+	// Code is the code of the account if it's written, nil otherwise
+	Code    []byte
+	Storage Storage
+}
+
+// CollectDiff merges the accounts written by `Commit` into the diff, the fake storage
+// set by the state overrides isn't supported.
+func (s *StateDB) CollectDiff(diff StateDiff) {
+	for _, addr := range s.journal.sortedDirties() {
+		obj := s.stateObjects[addr]
+		acct, found := diff[addr]
+		if !found || obj.suicided {
+			acct = &AccountDiff{Storage: make(Storage)}
+			diff[addr] = acct
+		}
+		if obj.suicided {
+			acct.Deleted = true
+			continue
+		}
+
+		acct.Exists = true
+		acct.Account = Account{
+			Nonce:    obj.account.Nonce,
+			Balance:  new(big.Int).Set(obj.account.Balance),
+			CodeHash: obj.account.CodeHash,
+		}
+		if obj.code != nil && obj.dirtyCode {
+			acct.Code = obj.code
+		}
+		for key, value := range obj.dirtyStorage {
+			// Skip noop changes like `Commit` does
+			if value == obj.originStorage[key] {
+				continue
+			}
+			acct.Storage[key] = value
+		}
+	}
+}
+
+// commitFakeStorage replaces the whole storage of the account in keeper with
+// the fake storage set by `SetStorage`, the dirty states are applied on top of it.
+func (s *StateDB) commitFakeStorage(obj *stateObject) {
+	var keys []common.Hash
+	s.keeper.ForEachStorage(s.ctx, obj.Address(), func(key, _ common.Hash) bool {
+		keys = append(keys, key)
+		return true
+	})
+	for _, key := range keys {
+		s.keeper.SetState(s.ctx, obj.Address(), key, nil)
+	}
+
+	storage := make(Storage, len(obj.fakeStorage)+len(obj.dirtyStorage))
+	for key, value := range obj.fakeStorage {
+		storage[key] = value
+	}
+	for key, value := range obj.dirtyStorage {
+		storage[key] = value
+	}
+	for _, key := range storage.SortedKeys() {
+		value := storage[key]
+		if value == (common.Hash{}) {
+			continue
+		}
+		s.keeper.SetState(s.ctx, obj.Address(), key, value.Bytes())
+	}
+}
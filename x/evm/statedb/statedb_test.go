@@ -244,6 +244,134 @@ func (suite *StateDBTestSuite) TestState() {
 	}
 }
 
+func (suite *StateDBTestSuite) TestSetStorage() {
+	key1 := common.BigToHash(big.NewInt(1))
+	value1 := common.BigToHash(big.NewInt(2))
+	key2 := common.BigToHash(big.NewInt(3))
+	value2 := common.BigToHash(big.NewInt(4))
+
+	keeper := NewMockKeeper()
+	db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	db.SetState(address, key1, value1)
+	suite.Require().NoError(db.Commit())
+
+	db = statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	suite.Require().Equal(value1, db.GetState(address, key1))
+
+	// the committed storage is ignored after the storage is replaced
+	db.SetStorage(address, map[common.Hash]common.Hash{key2: value2})
+	suite.Require().Equal(common.Hash{}, db.GetState(address, key1))
+	suite.Require().Equal(common.Hash{}, db.GetCommittedState(address, key1))
+	suite.Require().Equal(value2, db.GetState(address, key2))
+	suite.Require().Equal(value2, db.GetCommittedState(address, key2))
+	suite.Require().Equal(statedb.Storage{key2: value2}, CollectContractStorage(db))
+
+	// dirty states are still visible on top of the replaced storage
+	db.SetState(address, key2, value1)
+	suite.Require().Equal(value1, db.GetState(address, key2))
+	suite.Require().Equal(value2, db.GetCommittedState(address, key2))
+
+	// the committed storage is replaced entirely
+	suite.Require().NoError(db.Commit())
+	suite.Require().Equal(statedb.Storage{key2: value1}, keeper.accounts[address].states)
+
+	// replacing only the storage of an existing account is committed
+	db = statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	db.SetStorage(address, map[common.Hash]common.Hash{key1: value2})
+	suite.Require().NoError(db.Commit())
+	suite.Require().Equal(statedb.Storage{key1: value2}, keeper.accounts[address].states)
+
+	// the replacement is reverted with the snapshot
+	db = statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	snapshot := db.Snapshot()
+	db.SetStorage(address, map[common.Hash]common.Hash{key2: value2})
+	db.RevertToSnapshot(snapshot)
+	suite.Require().Equal(value2, db.GetState(address, key1))
+	suite.Require().NoError(db.Commit())
+	suite.Require().Equal(statedb.Storage{key1: value2}, keeper.accounts[address].states)
+}
+
+func (suite *StateDBTestSuite) TestCommitment() {
+	key1 := common.BigToHash(big.NewInt(1))
+	value1 := common.BigToHash(big.NewInt(2))
+
+	commitment := func(malleate func(*statedb.StateDB)) common.Hash {
+		db := statedb.New(sdk.Context{}, NewMockKeeper(), emptyTxConfig)
+		malleate(db)
+		suite.Require().NoError(db.Commit())
+		return db.Commitment()
+	}
+
+	base := commitment(func(db *statedb.StateDB) {
+		db.AddBalance(address, big.NewInt(100))
+		db.SetState(address2, key1, value1)
+	})
+	// deterministic regardless of the write order
+	suite.Require().Equal(base, commitment(func(db *statedb.StateDB) {
+		db.SetState(address2, key1, value1)
+		db.AddBalance(address, big.NewInt(100))
+	}))
+	suite.Require().NotEqual(base, commitment(func(db *statedb.StateDB) {
+		db.AddBalance(address, big.NewInt(101))
+		db.SetState(address2, key1, value1)
+	}))
+	suite.Require().NotEqual(base, commitment(func(db *statedb.StateDB) {
+		db.AddBalance(address, big.NewInt(100))
+		db.SetState(address2, key1, value1)
+		db.SetNonce(address3, 1)
+	}))
+	// noop storage changes are skipped like in `Commit`
+	suite.Require().Equal(
+		commitment(func(db *statedb.StateDB) { db.SetNonce(address, 1) }),
+		commitment(func(db *statedb.StateDB) {
+			db.SetNonce(address, 1)
+			db.SetState(address, key1, common.Hash{})
+		}),
+	)
+}
+
+func (suite *StateDBTestSuite) TestCollectDiff() {
+	key1 := common.BigToHash(big.NewInt(1))
+	value1 := common.BigToHash(big.NewInt(2))
+	code := []byte("hello world")
+	keeper := NewMockKeeper()
+	diff := make(statedb.StateDiff)
+
+	db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	db.AddBalance(address, big.NewInt(100))
+	db.SetCode(address2, code)
+	db.SetState(address2, key1, value1)
+	db.SetNonce(address3, 1)
+	suite.Require().NoError(db.Commit())
+	db.CollectDiff(diff)
+
+	// the diffs of the later commits are merged
+	db = statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	db.SetNonce(address, 1)
+	db.SetState(address2, key1, value1)
+	db.Suicide(address3)
+	suite.Require().NoError(db.Commit())
+	db.CollectDiff(diff)
+
+	suite.Require().Equal(statedb.StateDiff{
+		address: {
+			Exists:  true,
+			Account: statedb.Account{Nonce: 1, Balance: big.NewInt(100), CodeHash: emptyCodeHash},
+			Storage: statedb.Storage{},
+		},
+		address2: {
+			Exists:  true,
+			Account: statedb.Account{Balance: big.NewInt(0), CodeHash: crypto.Keccak256(code)},
+			Code:    code,
+			Storage: statedb.Storage{key1: value1},
+		},
+		address3: {
+			Deleted: true,
+			Storage: statedb.Storage{},
+		},
+	}, diff)
+}
+
 func (suite *StateDBTestSuite) TestCode() {
 	code := []byte("hello world")
 	codeHash := crypto.Keccak256Hash(code)
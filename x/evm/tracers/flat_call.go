@@ -0,0 +1,292 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package tracers
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+	ethtracers "github.com/ethereum/go-ethereum/eth/tracers"
+)
+
+// FlatCallTracerName is the name of the native tracer returning the Parity style flat
+// call traces of a transaction.
+const FlatCallTracerName = "flatCallTracer"
+
+// Parity trace types
+const (
+	TraceTypeCall    = "call"
+	TraceTypeCreate  = "create"
+	TraceTypeSuicide = "suicide"
+)
+
+// FlatCallAction is the action of a Parity style trace, the fields depend on the trace type:
+//   - call: callType, from, gas, input, to, value
+//   - create: from, gas, init, value
+//   - suicide: address, refundAddress, balance
+type FlatCallAction struct {
+	CallType      string          `json:"callType,omitempty"`
+	From          *common.Address `json:"from,omitempty"`
+	Gas           *hexutil.Uint64 `json:"gas,omitempty"`
+	Input         *hexutil.Bytes  `json:"input,omitempty"`
+	Init          *hexutil.Bytes  `json:"init,omitempty"`
+	To            *common.Address `json:"to,omitempty"`
+	Value         *hexutil.Big    `json:"value,omitempty"`
+	Address       *common.Address `json:"address,omitempty"`
+	RefundAddress *common.Address `json:"refundAddress,omitempty"`
+	Balance       *hexutil.Big    `json:"balance,omitempty"`
+}
+
+// FlatCallResult is the result of a successful Parity style trace, the fields depend on
+// the trace type:
+//   - call: gasUsed, output
+//   - create: address, code, gasUsed
+type FlatCallResult struct {
+	Address *common.Address `json:"address,omitempty"`
+	Code    *hexutil.Bytes  `json:"code,omitempty"`
+	GasUsed hexutil.Uint64  `json:"gasUsed"`
+	Output  *hexutil.Bytes  `json:"output,omitempty"`
+}
+
+// FlatCallFrame is a Parity style trace of a call frame.
+type FlatCallFrame struct {
+	Action              FlatCallAction  `json:"action"`
+	BlockHash           common.Hash     `json:"blockHash"`
+	BlockNumber         uint64          `json:"blockNumber"`
+	Error               string          `json:"error,omitempty"`
+	Result              *FlatCallResult `json:"result"`
+	Subtraces           int             `json:"subtraces"`
+	TraceAddress        []int           `json:"traceAddress"`
+	TransactionHash     common.Hash     `json:"transactionHash"`
+	TransactionPosition uint64          `json:"transactionPosition"`
+	Type                string          `json:"type"`
+}
+
+// callNode is a call frame along with its sub calls
+type callNode struct {
+	frame FlatCallFrame
+	calls []*callNode
+}
+
+// FlatCallTracer is a native tracer collecting the call frames of a transaction, the
+// result is the list of the frames in Parity trace format, ordered depth first. The
+// block number of the frames isn't known by the tracer and is left zero.
+type FlatCallTracer struct {
+	ctx       *ethtracers.Context
+	env       *vm.EVM
+	callstack []*callNode
+	root      *callNode
+	interrupt uint32 // Atomic flag to signal execution interruption
+	reason    error  // Textual reason for the interruption
+}
+
+var _ ethtracers.Tracer = &FlatCallTracer{}
+
+// NewFlatCallTracer creates a FlatCallTracer for the transaction of the context
+func NewFlatCallTracer(ctx *ethtracers.Context) *FlatCallTracer {
+	if ctx == nil {
+		ctx = &ethtracers.Context{}
+	}
+	return &FlatCallTracer{ctx: ctx}
+}
+
+// CaptureTxStart implements the EVMLogger interface
+func (t *FlatCallTracer) CaptureTxStart(_ uint64) {}
+
+// CaptureTxEnd implements the EVMLogger interface
+func (t *FlatCallTracer) CaptureTxEnd(_ uint64) {}
+
+// CaptureStart implements the EVMLogger interface to initialize the top call frame.
+func (t *FlatCallTracer) CaptureStart(env *vm.EVM, from, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	t.env = env
+	typ := vm.CALL
+	if create {
+		typ = vm.CREATE
+	}
+	t.root = newCallNode(typ, from, to, input, gas, value)
+	t.callstack = []*callNode{t.root}
+}
+
+// CaptureEnd implements the EVMLogger interface to finalize the top call frame.
+func (t *FlatCallTracer) CaptureEnd(output []byte, gasUsed uint64, _ time.Duration, err error) {
+	if t.root == nil {
+		return
+	}
+	t.root.setResult(output, gasUsed, err)
+}
+
+// CaptureEnter implements the EVMLogger interface to push a call frame entered via
+// call, create or selfdestruct.
+func (t *FlatCallTracer) CaptureEnter(typ vm.OpCode, from, to common.Address, input []byte, gas uint64, value *big.Int) {
+	// Skip if tracing was interrupted
+	if atomic.LoadUint32(&t.interrupt) > 0 {
+		t.env.Cancel()
+		return
+	}
+	if len(t.callstack) == 0 {
+		return
+	}
+
+	node := newCallNode(typ, from, to, input, gas, value)
+	parent := t.callstack[len(t.callstack)-1]
+	parent.calls = append(parent.calls, node)
+	t.callstack = append(t.callstack, node)
+}
+
+// CaptureExit implements the EVMLogger interface to pop the current call frame.
+func (t *FlatCallTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
+	size := len(t.callstack)
+	if size <= 1 {
+		return
+	}
+	node := t.callstack[size-1]
+	t.callstack = t.callstack[:size-1]
+	node.setResult(output, gasUsed, err)
+}
+
+// CaptureState implements the EVMLogger interface
+func (t *FlatCallTracer) CaptureState(_ uint64, _ vm.OpCode, _, _ uint64, _ *vm.ScopeContext, _ []byte, _ int, _ error) {
+}
+
+// CaptureFault implements the EVMLogger interface
+func (t *FlatCallTracer) CaptureFault(_ uint64, _ vm.OpCode, _, _ uint64, _ *vm.ScopeContext, _ int, _ error) {
+}
+
+// GetResult returns the json-encoded list of the flat call frames, and any error
+// arising from the encoding or forceful termination (via `Stop`).
+func (t *FlatCallTracer) GetResult() (json.RawMessage, error) {
+	if t.root == nil {
+		return nil, errors.New("no call frame captured")
+	}
+
+	frames := t.flatten(t.root, []int{}, []FlatCallFrame{})
+	res, err := json.Marshal(frames)
+	if err != nil {
+		return nil, err
+	}
+	return res, t.reason
+}
+
+// Stop terminates execution of the tracer at the first opportune moment.
+func (t *FlatCallTracer) Stop(err error) {
+	t.reason = err
+	atomic.StoreUint32(&t.interrupt, 1)
+}
+
+// flatten appends the frame of the node and the ones of its sub calls depth first.
+func (t *FlatCallTracer) flatten(node *callNode, traceAddress []int, frames []FlatCallFrame) []FlatCallFrame {
+	frame := node.frame
+	frame.BlockHash = t.ctx.BlockHash
+	frame.TransactionHash = t.ctx.TxHash
+	frame.TransactionPosition = uint64(t.ctx.TxIndex)
+	frame.Subtraces = len(node.calls)
+	frame.TraceAddress = traceAddress
+	frames = append(frames, frame)
+
+	for i, call := range node.calls {
+		childAddress := make([]int, len(traceAddress), len(traceAddress)+1)
+		copy(childAddress, traceAddress)
+		frames = t.flatten(call, append(childAddress, i), frames)
+	}
+	return frames
+}
+
+func newCallNode(typ vm.OpCode, from, to common.Address, input []byte, gas uint64, value *big.Int) *callNode {
+	if value == nil {
+		value = new(big.Int)
+	}
+
+	node := &callNode{}
+	switch typ {
+	case vm.CREATE, vm.CREATE2:
+		node.frame.Type = TraceTypeCreate
+		node.frame.Action = FlatCallAction{
+			From:  &from,
+			Gas:   (*hexutil.Uint64)(&gas),
+			Init:  (*hexutil.Bytes)(&input),
+			Value: (*hexutil.Big)(value),
+		}
+		// the created address is part of the result
+		node.frame.Result = &FlatCallResult{Address: &to}
+	case vm.SELFDESTRUCT:
+		node.frame.Type = TraceTypeSuicide
+		node.frame.Action = FlatCallAction{
+			Address:       &from,
+			RefundAddress: &to,
+			Balance:       (*hexutil.Big)(value),
+		}
+	default:
+		node.frame.Type = TraceTypeCall
+		node.frame.Action = FlatCallAction{
+			CallType: strings.ToLower(typ.String()),
+			From:     &from,
+			Gas:      (*hexutil.Uint64)(&gas),
+			Input:    (*hexutil.Bytes)(&input),
+			To:       &to,
+			Value:    (*hexutil.Big)(value),
+		}
+	}
+	return node
+}
+
+// setResult sets the result or the error of the call frame, the result of a failed
+// frame is nil.
+func (n *callNode) setResult(output []byte, gasUsed uint64, err error) {
+	if n.frame.Type == TraceTypeSuicide {
+		return
+	}
+
+	if err != nil {
+		n.frame.Error = parityError(err)
+		n.frame.Result = nil
+		return
+	}
+
+	if n.frame.Type == TraceTypeCreate {
+		n.frame.Result.Code = (*hexutil.Bytes)(&output)
+		n.frame.Result.GasUsed = hexutil.Uint64(gasUsed)
+		return
+	}
+	n.frame.Result = &FlatCallResult{
+		GasUsed: hexutil.Uint64(gasUsed),
+		Output:  (*hexutil.Bytes)(&output),
+	}
+}
+
+// parityError converts the evm errors to the messages used by Parity traces
+func parityError(err error) string {
+	switch {
+	case errors.Is(err, vm.ErrExecutionReverted):
+		return "Reverted"
+	case errors.Is(err, vm.ErrOutOfGas), errors.Is(err, vm.ErrCodeStoreOutOfGas), errors.Is(err, vm.ErrGasUintOverflow):
+		return "Out of gas"
+	case errors.Is(err, vm.ErrInvalidJump):
+		return "Bad jump destination"
+	case errors.Is(err, vm.ErrWriteProtection):
+		return "Mutable Call In Static Context"
+	case errors.Is(err, vm.ErrDepth):
+		return "Out of stack"
+	default:
+		return err.Error()
+	}
+}
@@ -0,0 +1,97 @@
+package tracers_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+	ethtracers "github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/x/evm/tracers"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+func TestFlatCallTracer(t *testing.T) {
+	sender := tests.GenerateAddress()
+	contract := tests.GenerateAddress()
+	callee := tests.GenerateAddress()
+	created := tests.GenerateAddress()
+	refund := tests.GenerateAddress()
+	txCtx := &ethtracers.Context{
+		BlockHash: common.BytesToHash([]byte("block")),
+		TxIndex:   2,
+		TxHash:    common.BytesToHash([]byte("tx")),
+	}
+
+	constructor, found := evmtypes.LookupTracer(tracers.FlatCallTracerName)
+	require.True(t, found)
+	tracer, err := constructor(txCtx, nil)
+	require.NoError(t, err)
+
+	tracer.CaptureStart(nil, sender, contract, false, []byte{1}, 100000, big.NewInt(10))
+	tracer.CaptureEnter(vm.STATICCALL, contract, callee, []byte{2}, 50000, nil)
+	tracer.CaptureExit(nil, 1000, vm.ErrExecutionReverted)
+	tracer.CaptureEnter(vm.CREATE2, contract, created, []byte{3}, 40000, big.NewInt(1))
+	tracer.CaptureEnter(vm.SELFDESTRUCT, created, refund, nil, 0, big.NewInt(1))
+	tracer.CaptureExit(nil, 0, nil)
+	tracer.CaptureExit([]byte{4}, 2000, nil)
+	tracer.CaptureEnd([]byte{5}, 21000, 0, nil)
+
+	res, err := tracer.GetResult()
+	require.NoError(t, err)
+
+	var frames []tracers.FlatCallFrame
+	require.NoError(t, json.Unmarshal(res, &frames))
+	require.Len(t, frames, 4)
+
+	for _, frame := range frames {
+		require.Equal(t, txCtx.BlockHash, frame.BlockHash)
+		require.Equal(t, txCtx.TxHash, frame.TransactionHash)
+		require.Equal(t, uint64(2), frame.TransactionPosition)
+	}
+
+	root := frames[0]
+	require.Equal(t, tracers.TraceTypeCall, root.Type)
+	require.Equal(t, "call", root.Action.CallType)
+	require.Equal(t, sender, *root.Action.From)
+	require.Equal(t, contract, *root.Action.To)
+	require.Equal(t, 2, root.Subtraces)
+	require.Equal(t, []int{}, root.TraceAddress)
+	require.Equal(t, hexutil.Uint64(21000), root.Result.GasUsed)
+	require.Equal(t, hexutil.Bytes{5}, *root.Result.Output)
+
+	reverted := frames[1]
+	require.Equal(t, "staticcall", reverted.Action.CallType)
+	require.Equal(t, callee, *reverted.Action.To)
+	require.Equal(t, []int{0}, reverted.TraceAddress)
+	require.Equal(t, "Reverted", reverted.Error)
+	require.Nil(t, reverted.Result)
+
+	create := frames[2]
+	require.Equal(t, tracers.TraceTypeCreate, create.Type)
+	require.Equal(t, hexutil.Bytes{3}, *create.Action.Init)
+	require.Equal(t, []int{1}, create.TraceAddress)
+	require.Equal(t, 1, create.Subtraces)
+	require.Equal(t, created, *create.Result.Address)
+	require.Equal(t, hexutil.Bytes{4}, *create.Result.Code)
+	require.Equal(t, hexutil.Uint64(2000), create.Result.GasUsed)
+
+	suicide := frames[3]
+	require.Equal(t, tracers.TraceTypeSuicide, suicide.Type)
+	require.Equal(t, created, *suicide.Action.Address)
+	require.Equal(t, refund, *suicide.Action.RefundAddress)
+	require.Equal(t, big.NewInt(1), suicide.Action.Balance.ToInt())
+	require.Equal(t, []int{1, 0}, suicide.TraceAddress)
+	require.Nil(t, suicide.Result)
+}
+
+func TestFlatCallTracerNoFrame(t *testing.T) {
+	tracer := tracers.NewFlatCallTracer(nil)
+	_, err := tracer.GetResult()
+	require.Error(t, err)
+}
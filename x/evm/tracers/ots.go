@@ -0,0 +1,267 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package tracers
+
+import (
+	"encoding/json"
+	"math/big"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+	ethtracers "github.com/ethereum/go-ethereum/eth/tracers"
+)
+
+const (
+	// OtsInternalOperationsTracerName is the name of the native tracer returning the
+	// internal value transfers, contract creations and self destructs of a transaction,
+	// as expected by Otterscan.
+	OtsInternalOperationsTracerName = "otsInternalOperationsTracer"
+	// OtsTraceTracerName is the name of the native tracer returning the call frames of a
+	// transaction in the order they are entered, as expected by Otterscan.
+	OtsTraceTracerName = "otsTraceTracer"
+)
+
+// OperationType is the type of an internal operation
+type OperationType int
+
+// Otterscan internal operation types
+const (
+	OperationTransfer     OperationType = 0
+	OperationSelfDestruct OperationType = 1
+	OperationCreate       OperationType = 2
+	OperationCreate2      OperationType = 3
+)
+
+// InternalOperation is an internal value transfer, contract creation or self destruct
+type InternalOperation struct {
+	Type  OperationType  `json:"type"`
+	From  common.Address `json:"from"`
+	To    common.Address `json:"to"`
+	Value *hexutil.Big   `json:"value"`
+}
+
+// OtsInternalOperationsTracer is a native tracer collecting the internal operations of
+// a transaction, the top call frame isn't an internal operation.
+type OtsInternalOperationsTracer struct {
+	env        *vm.EVM
+	operations []*InternalOperation
+	interrupt  uint32 // Atomic flag to signal execution interruption
+	reason     error  // Textual reason for the interruption
+}
+
+var _ ethtracers.Tracer = &OtsInternalOperationsTracer{}
+
+// NewOtsInternalOperationsTracer creates an OtsInternalOperationsTracer
+func NewOtsInternalOperationsTracer() *OtsInternalOperationsTracer {
+	return &OtsInternalOperationsTracer{operations: []*InternalOperation{}}
+}
+
+// CaptureTxStart implements the EVMLogger interface
+func (t *OtsInternalOperationsTracer) CaptureTxStart(_ uint64) {}
+
+// CaptureTxEnd implements the EVMLogger interface
+func (t *OtsInternalOperationsTracer) CaptureTxEnd(_ uint64) {}
+
+// CaptureStart implements the EVMLogger interface
+func (t *OtsInternalOperationsTracer) CaptureStart(env *vm.EVM, _, _ common.Address, _ bool, _ []byte, _ uint64, _ *big.Int) {
+	t.env = env
+}
+
+// CaptureEnd implements the EVMLogger interface
+func (t *OtsInternalOperationsTracer) CaptureEnd(_ []byte, _ uint64, _ time.Duration, _ error) {}
+
+// CaptureEnter implements the EVMLogger interface to record the value transfers, the
+// contract creations and the self destructs.
+func (t *OtsInternalOperationsTracer) CaptureEnter(typ vm.OpCode, from, to common.Address, _ []byte, _ uint64, value *big.Int) {
+	// Skip if tracing was interrupted
+	if atomic.LoadUint32(&t.interrupt) > 0 {
+		t.env.Cancel()
+		return
+	}
+
+	var opType OperationType
+	switch typ {
+	case vm.CALL:
+		if value == nil || value.Sign() == 0 {
+			return
+		}
+		opType = OperationTransfer
+	case vm.CREATE:
+		opType = OperationCreate
+	case vm.CREATE2:
+		opType = OperationCreate2
+	case vm.SELFDESTRUCT:
+		opType = OperationSelfDestruct
+	default:
+		return
+	}
+
+	if value == nil {
+		value = new(big.Int)
+	}
+	t.operations = append(t.operations, &InternalOperation{
+		Type:  opType,
+		From:  from,
+		To:    to,
+		Value: (*hexutil.Big)(new(big.Int).Set(value)),
+	})
+}
+
+// CaptureExit implements the EVMLogger interface
+func (t *OtsInternalOperationsTracer) CaptureExit(_ []byte, _ uint64, _ error) {}
+
+// CaptureState implements the EVMLogger interface
+func (t *OtsInternalOperationsTracer) CaptureState(_ uint64, _ vm.OpCode, _, _ uint64, _ *vm.ScopeContext, _ []byte, _ int, _ error) {
+}
+
+// CaptureFault implements the EVMLogger interface
+func (t *OtsInternalOperationsTracer) CaptureFault(_ uint64, _ vm.OpCode, _, _ uint64, _ *vm.ScopeContext, _ int, _ error) {
+}
+
+// GetResult returns the json-encoded list of the internal operations, and any error
+// arising from the encoding or forceful termination (via `Stop`).
+func (t *OtsInternalOperationsTracer) GetResult() (json.RawMessage, error) {
+	res, err := json.Marshal(t.operations)
+	if err != nil {
+		return nil, err
+	}
+	return res, t.reason
+}
+
+// Stop terminates execution of the tracer at the first opportune moment.
+func (t *OtsInternalOperationsTracer) Stop(err error) {
+	t.reason = err
+	atomic.StoreUint32(&t.interrupt, 1)
+}
+
+// TraceEntry is a call frame of a transaction, the value is nil for the static and
+// delegate calls.
+type TraceEntry struct {
+	Type   string         `json:"type"`
+	Depth  int            `json:"depth"`
+	From   common.Address `json:"from"`
+	To     common.Address `json:"to"`
+	Value  *hexutil.Big   `json:"value"`
+	Input  hexutil.Bytes  `json:"input"`
+	Output hexutil.Bytes  `json:"output"`
+}
+
+// OtsTraceTracer is a native tracer collecting the call frames of a transaction in the
+// order they are entered, including the top call frame.
+type OtsTraceTracer struct {
+	env       *vm.EVM
+	entries   []*TraceEntry
+	callstack []*TraceEntry
+	interrupt uint32 // Atomic flag to signal execution interruption
+	reason    error  // Textual reason for the interruption
+}
+
+var _ ethtracers.Tracer = &OtsTraceTracer{}
+
+// NewOtsTraceTracer creates an OtsTraceTracer
+func NewOtsTraceTracer() *OtsTraceTracer {
+	return &OtsTraceTracer{entries: []*TraceEntry{}}
+}
+
+// CaptureTxStart implements the EVMLogger interface
+func (t *OtsTraceTracer) CaptureTxStart(_ uint64) {}
+
+// CaptureTxEnd implements the EVMLogger interface
+func (t *OtsTraceTracer) CaptureTxEnd(_ uint64) {}
+
+// CaptureStart implements the EVMLogger interface to record the top call frame.
+func (t *OtsTraceTracer) CaptureStart(env *vm.EVM, from, to common.Address, create bool, input []byte, _ uint64, value *big.Int) {
+	t.env = env
+	typ := vm.CALL
+	if create {
+		typ = vm.CREATE
+	}
+	t.push(typ, from, to, input, value)
+}
+
+// CaptureEnd implements the EVMLogger interface to set the output of the top call frame.
+func (t *OtsTraceTracer) CaptureEnd(output []byte, _ uint64, _ time.Duration, _ error) {
+	t.pop(output)
+}
+
+// CaptureEnter implements the EVMLogger interface to record a call frame.
+func (t *OtsTraceTracer) CaptureEnter(typ vm.OpCode, from, to common.Address, input []byte, _ uint64, value *big.Int) {
+	// Skip if tracing was interrupted
+	if atomic.LoadUint32(&t.interrupt) > 0 {
+		t.env.Cancel()
+		return
+	}
+	t.push(typ, from, to, input, value)
+}
+
+// CaptureExit implements the EVMLogger interface to set the output of the call frame.
+func (t *OtsTraceTracer) CaptureExit(output []byte, _ uint64, _ error) {
+	t.pop(output)
+}
+
+// CaptureState implements the EVMLogger interface
+func (t *OtsTraceTracer) CaptureState(_ uint64, _ vm.OpCode, _, _ uint64, _ *vm.ScopeContext, _ []byte, _ int, _ error) {
+}
+
+// CaptureFault implements the EVMLogger interface
+func (t *OtsTraceTracer) CaptureFault(_ uint64, _ vm.OpCode, _, _ uint64, _ *vm.ScopeContext, _ int, _ error) {
+}
+
+// GetResult returns the json-encoded list of the call frames, and any error arising
+// from the encoding or forceful termination (via `Stop`).
+func (t *OtsTraceTracer) GetResult() (json.RawMessage, error) {
+	res, err := json.Marshal(t.entries)
+	if err != nil {
+		return nil, err
+	}
+	return res, t.reason
+}
+
+// Stop terminates execution of the tracer at the first opportune moment.
+func (t *OtsTraceTracer) Stop(err error) {
+	t.reason = err
+	atomic.StoreUint32(&t.interrupt, 1)
+}
+
+func (t *OtsTraceTracer) push(typ vm.OpCode, from, to common.Address, input []byte, value *big.Int) {
+	entry := &TraceEntry{
+		Type:  typ.String(),
+		Depth: len(t.callstack),
+		From:  from,
+		To:    to,
+		Input: common.CopyBytes(input),
+	}
+	if typ != vm.STATICCALL && typ != vm.DELEGATECALL {
+		if value == nil {
+			value = new(big.Int)
+		}
+		entry.Value = (*hexutil.Big)(new(big.Int).Set(value))
+	}
+	t.entries = append(t.entries, entry)
+	t.callstack = append(t.callstack, entry)
+}
+
+func (t *OtsTraceTracer) pop(output []byte) {
+	size := len(t.callstack)
+	if size == 0 {
+		return
+	}
+	t.callstack[size-1].Output = common.CopyBytes(output)
+	t.callstack = t.callstack[:size-1]
+}
@@ -0,0 +1,87 @@
+package tracers_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+	ethtracers "github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/x/evm/tracers"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// captureCalls drives the tracer through a tx calling a contract, which static calls
+// a second contract, transfers value, creates a contract and self destructs it.
+func captureCalls(tracer ethtracers.Tracer, addrs []common.Address) {
+	sender, contract, callee, created, refund := addrs[0], addrs[1], addrs[2], addrs[3], addrs[4]
+
+	tracer.CaptureStart(nil, sender, contract, false, []byte{1}, 100000, big.NewInt(10))
+	tracer.CaptureEnter(vm.STATICCALL, contract, callee, []byte{2}, 50000, nil)
+	tracer.CaptureExit([]byte{3}, 1000, nil)
+	tracer.CaptureEnter(vm.CALL, contract, callee, nil, 2300, big.NewInt(5))
+	tracer.CaptureExit(nil, 0, nil)
+	tracer.CaptureEnter(vm.CALL, contract, callee, nil, 2300, big.NewInt(0))
+	tracer.CaptureExit(nil, 0, nil)
+	tracer.CaptureEnter(vm.CREATE2, contract, created, []byte{4}, 40000, big.NewInt(1))
+	tracer.CaptureEnter(vm.SELFDESTRUCT, created, refund, nil, 0, big.NewInt(1))
+	tracer.CaptureExit(nil, 0, nil)
+	tracer.CaptureExit([]byte{5}, 2000, nil)
+	tracer.CaptureEnd([]byte{6}, 21000, 0, vm.ErrExecutionReverted)
+}
+
+func TestOtsInternalOperationsTracer(t *testing.T) {
+	addrs := make([]common.Address, 5)
+	for i := range addrs {
+		addrs[i] = tests.GenerateAddress()
+	}
+
+	constructor, found := evmtypes.LookupTracer(tracers.OtsInternalOperationsTracerName)
+	require.True(t, found)
+	tracer, err := constructor(nil, nil)
+	require.NoError(t, err)
+	captureCalls(tracer, addrs)
+
+	res, err := tracer.GetResult()
+	require.NoError(t, err)
+
+	expOperations, err := json.Marshal([]tracers.InternalOperation{
+		{Type: tracers.OperationTransfer, From: addrs[1], To: addrs[2], Value: (*hexutil.Big)(big.NewInt(5))},
+		{Type: tracers.OperationCreate2, From: addrs[1], To: addrs[3], Value: (*hexutil.Big)(big.NewInt(1))},
+		{Type: tracers.OperationSelfDestruct, From: addrs[3], To: addrs[4], Value: (*hexutil.Big)(big.NewInt(1))},
+	})
+	require.NoError(t, err)
+	require.JSONEq(t, string(expOperations), string(res))
+}
+
+func TestOtsTraceTracer(t *testing.T) {
+	addrs := make([]common.Address, 5)
+	for i := range addrs {
+		addrs[i] = tests.GenerateAddress()
+	}
+
+	constructor, found := evmtypes.LookupTracer(tracers.OtsTraceTracerName)
+	require.True(t, found)
+	tracer, err := constructor(nil, nil)
+	require.NoError(t, err)
+	captureCalls(tracer, addrs)
+
+	res, err := tracer.GetResult()
+	require.NoError(t, err)
+
+	expEntries, err := json.Marshal([]tracers.TraceEntry{
+		{Type: "CALL", Depth: 0, From: addrs[0], To: addrs[1], Value: (*hexutil.Big)(big.NewInt(10)), Input: hexutil.Bytes{1}, Output: hexutil.Bytes{6}},
+		{Type: "STATICCALL", Depth: 1, From: addrs[1], To: addrs[2], Input: hexutil.Bytes{2}, Output: hexutil.Bytes{3}},
+		{Type: "CALL", Depth: 1, From: addrs[1], To: addrs[2], Value: (*hexutil.Big)(big.NewInt(5)), Input: hexutil.Bytes{}, Output: hexutil.Bytes{}},
+		{Type: "CALL", Depth: 1, From: addrs[1], To: addrs[2], Value: (*hexutil.Big)(big.NewInt(0)), Input: hexutil.Bytes{}, Output: hexutil.Bytes{}},
+		{Type: "CREATE2", Depth: 1, From: addrs[1], To: addrs[3], Value: (*hexutil.Big)(big.NewInt(1)), Input: hexutil.Bytes{4}, Output: hexutil.Bytes{5}},
+		{Type: "SELFDESTRUCT", Depth: 2, From: addrs[3], To: addrs[4], Value: (*hexutil.Big)(big.NewInt(1)), Input: hexutil.Bytes{}, Output: hexutil.Bytes{}},
+	})
+	require.NoError(t, err)
+	require.JSONEq(t, string(expEntries), string(res))
+}
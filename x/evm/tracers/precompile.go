@@ -0,0 +1,195 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package tracers
+
+import (
+	"encoding/json"
+	"math/big"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+	ethtracers "github.com/ethereum/go-ethereum/eth/tracers"
+	precompile_modules "github.com/ethereum/go-ethereum/precompile/modules"
+)
+
+// PrecompileCallTracerName is the name of the native tracer returning the calls of a
+// transaction into the Cosmos stateful precompiles.
+const PrecompileCallTracerName = "precompileCallTracer"
+
+// PrecompileCall is a call frame into a stateful precompile, the value is nil for the
+// static and delegate calls.
+type PrecompileCall struct {
+	Type    string         `json:"type"`
+	Depth   int            `json:"depth"`
+	From    common.Address `json:"from"`
+	To      common.Address `json:"to"`
+	Value   *hexutil.Big   `json:"value"`
+	Gas     hexutil.Uint64 `json:"gas"`
+	GasUsed hexutil.Uint64 `json:"gasUsed"`
+	Input   hexutil.Bytes  `json:"input"`
+	Output  hexutil.Bytes  `json:"output"`
+	Error   string         `json:"error,omitempty"`
+}
+
+// precompileCallTracerConfig is the tracer JSON config of the PrecompileCallTracer.
+type precompileCallTracerConfig struct {
+	// Addresses restricts the traced precompiles, all the registered precompiles are
+	// traced if it's empty.
+	Addresses []common.Address `json:"addresses"`
+}
+
+// PrecompileCallTracer is a native tracer collecting the call frames of a transaction
+// whose callee is a stateful precompile, in the order they are entered.
+type PrecompileCallTracer struct {
+	env         *vm.EVM
+	precompiles map[common.Address]struct{}
+	calls       []*PrecompileCall
+	callstack   []*PrecompileCall // nil for the frames not calling a precompile
+	interrupt   uint32            // Atomic flag to signal execution interruption
+	reason      error             // Textual reason for the interruption
+}
+
+var _ ethtracers.Tracer = &PrecompileCallTracer{}
+
+// NewPrecompileCallTracer creates a PrecompileCallTracer, it implements
+// evmtypes.TracerConstructor so that apps can register it.
+func NewPrecompileCallTracer(_ *ethtracers.Context, cfg json.RawMessage) (ethtracers.Tracer, error) {
+	var config precompileCallTracerConfig
+	if cfg != nil {
+		if err := json.Unmarshal(cfg, &config); err != nil {
+			return nil, err
+		}
+	}
+
+	precompiles := make(map[common.Address]struct{})
+	if len(config.Addresses) > 0 {
+		for _, address := range config.Addresses {
+			precompiles[address] = struct{}{}
+		}
+	} else {
+		for _, module := range precompile_modules.RegisteredModules() {
+			precompiles[module.Address] = struct{}{}
+		}
+	}
+
+	return &PrecompileCallTracer{
+		precompiles: precompiles,
+		calls:       []*PrecompileCall{},
+	}, nil
+}
+
+// CaptureTxStart implements the EVMLogger interface
+func (t *PrecompileCallTracer) CaptureTxStart(_ uint64) {}
+
+// CaptureTxEnd implements the EVMLogger interface
+func (t *PrecompileCallTracer) CaptureTxEnd(_ uint64) {}
+
+// CaptureStart implements the EVMLogger interface to record the top call frame if the
+// tx calls a precompile directly.
+func (t *PrecompileCallTracer) CaptureStart(env *vm.EVM, from, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	t.env = env
+	typ := vm.CALL
+	if create {
+		typ = vm.CREATE
+	}
+	t.push(typ, from, to, input, gas, value)
+}
+
+// CaptureEnd implements the EVMLogger interface to set the result of the top call frame.
+func (t *PrecompileCallTracer) CaptureEnd(output []byte, gasUsed uint64, _ time.Duration, err error) {
+	t.pop(output, gasUsed, err)
+}
+
+// CaptureEnter implements the EVMLogger interface to record a call frame into a precompile.
+func (t *PrecompileCallTracer) CaptureEnter(typ vm.OpCode, from, to common.Address, input []byte, gas uint64, value *big.Int) {
+	// Skip if tracing was interrupted
+	if atomic.LoadUint32(&t.interrupt) > 0 {
+		t.env.Cancel()
+		return
+	}
+	t.push(typ, from, to, input, gas, value)
+}
+
+// CaptureExit implements the EVMLogger interface to set the result of the call frame.
+func (t *PrecompileCallTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
+	t.pop(output, gasUsed, err)
+}
+
+// CaptureState implements the EVMLogger interface
+func (t *PrecompileCallTracer) CaptureState(_ uint64, _ vm.OpCode, _, _ uint64, _ *vm.ScopeContext, _ []byte, _ int, _ error) {
+}
+
+// CaptureFault implements the EVMLogger interface
+func (t *PrecompileCallTracer) CaptureFault(_ uint64, _ vm.OpCode, _, _ uint64, _ *vm.ScopeContext, _ int, _ error) {
+}
+
+// GetResult returns the json-encoded list of the precompile calls, and any error arising
+// from the encoding or forceful termination (via `Stop`).
+func (t *PrecompileCallTracer) GetResult() (json.RawMessage, error) {
+	res, err := json.Marshal(t.calls)
+	if err != nil {
+		return nil, err
+	}
+	return res, t.reason
+}
+
+// Stop terminates execution of the tracer at the first opportune moment.
+func (t *PrecompileCallTracer) Stop(err error) {
+	t.reason = err
+	atomic.StoreUint32(&t.interrupt, 1)
+}
+
+func (t *PrecompileCallTracer) push(typ vm.OpCode, from, to common.Address, input []byte, gas uint64, value *big.Int) {
+	if _, found := t.precompiles[to]; !found {
+		t.callstack = append(t.callstack, nil)
+		return
+	}
+
+	call := &PrecompileCall{
+		Type:  typ.String(),
+		Depth: len(t.callstack),
+		From:  from,
+		To:    to,
+		Gas:   hexutil.Uint64(gas),
+		Input: common.CopyBytes(input),
+	}
+	if typ != vm.STATICCALL && typ != vm.DELEGATECALL {
+		if value == nil {
+			value = new(big.Int)
+		}
+		call.Value = (*hexutil.Big)(new(big.Int).Set(value))
+	}
+	t.calls = append(t.calls, call)
+	t.callstack = append(t.callstack, call)
+}
+
+func (t *PrecompileCallTracer) pop(output []byte, gasUsed uint64, err error) {
+	size := len(t.callstack)
+	if size == 0 {
+		return
+	}
+	if call := t.callstack[size-1]; call != nil {
+		call.Output = common.CopyBytes(output)
+		call.GasUsed = hexutil.Uint64(gasUsed)
+		if err != nil {
+			call.Error = err.Error()
+		}
+	}
+	t.callstack = t.callstack[:size-1]
+}
@@ -0,0 +1,56 @@
+package tracers_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/x/evm/tracers"
+)
+
+func TestPrecompileCallTracer(t *testing.T) {
+	sender, contract := tests.GenerateAddress(), tests.GenerateAddress()
+	precompile, other := tests.GenerateAddress(), tests.GenerateAddress()
+
+	cfg, err := json.Marshal(map[string]interface{}{"addresses": []common.Address{precompile}})
+	require.NoError(t, err)
+	tracer, err := tracers.NewPrecompileCallTracer(nil, cfg)
+	require.NoError(t, err)
+
+	tracer.CaptureStart(nil, sender, contract, false, []byte{1}, 100000, big.NewInt(10))
+	tracer.CaptureEnter(vm.STATICCALL, contract, precompile, []byte{2}, 50000, nil)
+	tracer.CaptureExit([]byte{3}, 1000, nil)
+	tracer.CaptureEnter(vm.CALL, contract, other, []byte{4}, 40000, big.NewInt(0))
+	tracer.CaptureEnter(vm.CALL, other, precompile, []byte{5}, 30000, big.NewInt(1))
+	tracer.CaptureExit(nil, 2000, vm.ErrExecutionReverted)
+	tracer.CaptureExit([]byte{6}, 3000, nil)
+	tracer.CaptureEnd([]byte{7}, 21000, 0, nil)
+
+	res, err := tracer.GetResult()
+	require.NoError(t, err)
+
+	expCalls, err := json.Marshal([]tracers.PrecompileCall{
+		{
+			Type: "STATICCALL", Depth: 1, From: contract, To: precompile,
+			Gas: 50000, GasUsed: 1000, Input: hexutil.Bytes{2}, Output: hexutil.Bytes{3},
+		},
+		{
+			Type: "CALL", Depth: 2, From: other, To: precompile, Value: (*hexutil.Big)(big.NewInt(1)),
+			Gas: 30000, GasUsed: 2000, Input: hexutil.Bytes{5}, Output: hexutil.Bytes{},
+			Error: vm.ErrExecutionReverted.Error(),
+		},
+	})
+	require.NoError(t, err)
+	require.JSONEq(t, string(expCalls), string(res))
+}
+
+func TestPrecompileCallTracerInvalidConfig(t *testing.T) {
+	_, err := tracers.NewPrecompileCallTracer(nil, json.RawMessage(`{"addresses":"0x01"}`))
+	require.Error(t, err)
+}
@@ -0,0 +1,38 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package tracers
+
+import (
+	"encoding/json"
+
+	ethtracers "github.com/ethereum/go-ethereum/eth/tracers"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// the tracers of the trace and ots namespaces are registered in the EVM tracer registry,
+// so that they are looked up like the app specific tracers
+func init() {
+	evmtypes.RegisterTracer(FlatCallTracerName, func(ctx *ethtracers.Context, _ json.RawMessage) (ethtracers.Tracer, error) {
+		return NewFlatCallTracer(ctx), nil
+	})
+	evmtypes.RegisterTracer(OtsInternalOperationsTracerName, func(*ethtracers.Context, json.RawMessage) (ethtracers.Tracer, error) {
+		return NewOtsInternalOperationsTracer(), nil
+	})
+	evmtypes.RegisterTracer(OtsTraceTracerName, func(*ethtracers.Context, json.RawMessage) (ethtracers.Tracer, error) {
+		return NewOtsTraceTracer(), nil
+	})
+}
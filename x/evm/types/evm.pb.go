@@ -584,6 +584,9 @@ type TraceConfig struct {
 	EnableReturnData bool `protobuf:"varint,12,opt,name=enable_return_data,json=enableReturnData,proto3" json:"enableReturnData"`
 	// tracer_json_config configures the tracer using a JSON string
 	TracerJsonConfig string `protobuf:"bytes,13,opt,name=tracer_json_config,json=tracerJsonConfig,proto3" json:"tracerConfig"`
+	// parallel traces the transactions of a block in a pool of workers, each from the
+	// state before the transaction, instead of one after another.
+	Parallel bool `protobuf:"varint,14,opt,name=parallel,proto3" json:"parallel,omitempty"`
 }
 
 func (m *TraceConfig) Reset()         { *m = TraceConfig{} }
@@ -696,6 +699,13 @@ func (m *TraceConfig) GetTracerJsonConfig() string {
 	return ""
 }
 
+func (m *TraceConfig) GetParallel() bool {
+	if m != nil {
+		return m.Parallel
+	}
+	return false
+}
+
 // EIP712AllowedMsg stores an allowed legacy msg and its eip712 type.
 type EIP712AllowedMsg struct {
 	// msg_type_url is a msg's proto type name. ie "/cosmos.bank.v1beta1.MsgSend"
@@ -896,122 +906,123 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/evm.proto", fileDescriptor_d21ecc92c8c8583e) }
 
 var fileDescriptor_d21ecc92c8c8583e = []byte{
-	// 1838 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0xdd, 0x6e, 0x1b, 0xb9,
-	0x15, 0x8e, 0xad, 0xb1, 0x3d, 0xa2, 0x64, 0x69, 0x4c, 0x29, 0x5e, 0x25, 0x41, 0x3d, 0xee, 0x14,
-	0x2d, 0x5c, 0x60, 0x63, 0xaf, 0xbd, 0x30, 0x12, 0x6c, 0xd0, 0x1f, 0xcb, 0xf1, 0xee, 0xda, 0x4d,
-	0x5c, 0x83, 0x71, 0x5a, 0xa0, 0x40, 0x31, 0xa0, 0x66, 0xb8, 0xa3, 0x89, 0x67, 0x86, 0x02, 0xc9,
-	0x51, 0xa4, 0xb6, 0x0f, 0x50, 0xa0, 0x37, 0x7d, 0x82, 0x62, 0x6f, 0xfb, 0x26, 0x8b, 0x5e, 0xed,
-	0x65, 0xd1, 0x8b, 0x41, 0xe1, 0xdc, 0xf9, 0xd2, 0x2f, 0xd0, 0x82, 0x3f, 0xfa, 0xb5, 0xb7, 0x5d,
-	0xfb, 0x4a, 0x3c, 0x3f, 0xfc, 0x3e, 0x9e, 0xc3, 0xc3, 0x39, 0xa4, 0xc0, 0x63, 0x22, 0xba, 0x84,
-	0xa5, 0x71, 0x26, 0x76, 0x48, 0x3f, 0xdd, 0xe9, 0xef, 0xca, 0x9f, 0xed, 0x1e, 0xa3, 0x82, 0x42,
-	0x67, 0x6c, 0xdb, 0x96, 0xca, 0xfe, 0xee, 0xe3, 0x66, 0x44, 0x23, 0xaa, 0x8c, 0x3b, 0x72, 0xa4,
-	0xfd, 0xbc, 0xbf, 0x5b, 0x60, 0xf9, 0x0c, 0x33, 0x9c, 0x72, 0xb8, 0x0b, 0xca, 0xa4, 0x9f, 0xfa,
-	0x21, 0xc9, 0x68, 0xda, 0x5a, 0xd8, 0x5c, 0xd8, 0x2a, 0xb7, 0x9b, 0xd7, 0x85, 0xeb, 0x0c, 0x71,
-	0x9a, 0x7c, 0xe6, 0x8d, 0x4d, 0x1e, 0xb2, 0x49, 0x3f, 0x7d, 0x29, 0x87, 0xf0, 0x67, 0x60, 0x95,
-	0x64, 0xb8, 0x93, 0x10, 0x3f, 0x60, 0x04, 0x0b, 0xd2, 0x5a, 0xdc, 0x5c, 0xd8, 0xb2, 0xdb, 0xad,
-	0xeb, 0xc2, 0x6d, 0x9a, 0x69, 0xd3, 0x66, 0x0f, 0x55, 0xb5, 0x7c, 0xa8, 0x44, 0xf8, 0x0c, 0x54,
-	0x46, 0x76, 0x9c, 0x24, 0xad, 0x92, 0x9a, 0xbc, 0x7e, 0x5d, 0xb8, 0x70, 0x76, 0x32, 0x4e, 0x12,
-	0x0f, 0x01, 0x33, 0x15, 0x27, 0x09, 0x3c, 0x00, 0x80, 0x0c, 0x04, 0xc3, 0x3e, 0x89, 0x7b, 0xbc,
-	0x65, 0x6d, 0x96, 0xb6, 0x4a, 0x6d, 0xef, 0xb2, 0x70, 0xcb, 0x47, 0x52, 0x7b, 0x74, 0x7c, 0xc6,
-	0xaf, 0x0b, 0x77, 0xcd, 0x80, 0x8c, 0x1d, 0x3d, 0x54, 0x56, 0xc2, 0x51, 0xdc, 0xe3, 0xf0, 0xf7,
-	0xa0, 0x1a, 0x74, 0x71, 0x9c, 0xf9, 0x01, 0xcd, 0xbe, 0x8a, 0xa3, 0xd6, 0xd2, 0xe6, 0xc2, 0x56,
-	0x65, 0xef, 0x07, 0xdb, 0xf3, 0x79, 0xdb, 0x3e, 0x94, 0x5e, 0x87, 0xca, 0xa9, 0xfd, 0xe4, 0x9b,
-	0xc2, 0x7d, 0x70, 0x5d, 0xb8, 0x0d, 0x0d, 0x3d, 0x0d, 0xe0, 0xa1, 0x4a, 0x30, 0xf1, 0x84, 0x29,
-	0x68, 0x90, 0xb8, 0xf7, 0x6c, 0x77, 0xcf, 0xc7, 0x49, 0x42, 0xdf, 0x93, 0xd0, 0x4f, 0x79, 0xc4,
-	0x5b, 0xcb, 0x9b, 0xa5, 0xad, 0xca, 0x9e, 0x77, 0x93, 0xe5, 0xe8, 0xf8, 0xec, 0xd9, 0xee, 0xde,
-	0x81, 0xf6, 0x7d, 0xcd, 0xa3, 0xf6, 0x23, 0x49, 0x75, 0x59, 0xb8, 0x6b, 0xf3, 0x16, 0x8e, 0xd6,
-	0x34, 0xf2, 0x94, 0x0a, 0xee, 0x81, 0x87, 0x8a, 0xc7, 0xcf, 0x33, 0xb9, 0xaf, 0x24, 0x10, 0x24,
-	0xf4, 0xc5, 0x80, 0xb7, 0x56, 0x64, 0x4e, 0x51, 0x43, 0x19, 0xdf, 0x4e, 0x6c, 0xe7, 0x03, 0x0e,
-	0x77, 0x40, 0x43, 0xa7, 0x34, 0xf4, 0x7b, 0x8c, 0x04, 0x34, 0xed, 0xc5, 0x09, 0xe1, 0x2d, 0x7b,
-	0xb3, 0xb4, 0x55, 0x46, 0xd0, 0x98, 0xce, 0x26, 0x16, 0xef, 0x6f, 0x6b, 0xa0, 0x72, 0x38, 0x13,
-	0x63, 0xbd, 0x4b, 0x53, 0xc2, 0x05, 0xc1, 0xa1, 0xdf, 0x49, 0x68, 0x70, 0x61, 0xca, 0xe6, 0xe5,
-	0xbf, 0x0a, 0xf7, 0x27, 0x51, 0x2c, 0xba, 0x79, 0x67, 0x3b, 0xa0, 0xe9, 0x4e, 0x40, 0x79, 0x4a,
-	0xb9, 0xf9, 0x79, 0xca, 0xc3, 0x8b, 0x1d, 0x31, 0xec, 0x11, 0xbe, 0x7d, 0x9c, 0x89, 0xeb, 0xc2,
-	0x5d, 0xd7, 0xc9, 0x9c, 0x83, 0xf2, 0x50, 0x6d, 0xac, 0x69, 0x4b, 0x05, 0x1c, 0x82, 0x5a, 0x88,
-	0xa9, 0xff, 0x15, 0x65, 0x17, 0x86, 0x6d, 0x51, 0xb1, 0xbd, 0xf9, 0xfe, 0x6c, 0x97, 0x85, 0x5b,
-	0x7d, 0x79, 0xf0, 0xeb, 0xcf, 0x29, 0xbb, 0x50, 0x98, 0xd7, 0x85, 0xfb, 0x50, 0xb3, 0xcf, 0x22,
-	0x7b, 0xa8, 0x1a, 0x62, 0x3a, 0x76, 0x83, 0xbf, 0x05, 0xce, 0xd8, 0x81, 0xe7, 0xbd, 0x1e, 0x65,
-	0xc2, 0x54, 0xeb, 0xd3, 0xcb, 0xc2, 0xad, 0x19, 0xc8, 0x37, 0xda, 0x72, 0x5d, 0xb8, 0x1f, 0xcd,
-	0x81, 0x9a, 0x39, 0x1e, 0xaa, 0x19, 0x58, 0xe3, 0x0a, 0x39, 0xa8, 0x92, 0xb8, 0xb7, 0xbb, 0xff,
-	0x89, 0x89, 0xc8, 0x52, 0x11, 0x9d, 0xdd, 0x29, 0xa2, 0xca, 0xd1, 0xf1, 0xd9, 0xee, 0xfe, 0x27,
-	0xa3, 0x80, 0x4c, 0x6d, 0x4e, 0xc3, 0x7a, 0xa8, 0xa2, 0x45, 0x1d, 0xcd, 0x31, 0x30, 0xa2, 0xdf,
-	0xc5, 0xbc, 0xab, 0x2a, 0xbf, 0xdc, 0xde, 0xba, 0x2c, 0x5c, 0xa0, 0x91, 0xbe, 0xc4, 0xbc, 0x3b,
-	0xd9, 0x97, 0xce, 0xf0, 0x0f, 0x38, 0x13, 0x71, 0x9e, 0x8e, 0xb0, 0x80, 0x9e, 0x2c, 0xbd, 0xc6,
-	0xeb, 0xdf, 0x37, 0xeb, 0x5f, 0xbe, 0xf7, 0xfa, 0xf7, 0x6f, 0x5b, 0xff, 0xfe, 0xec, 0xfa, 0xb5,
-	0xcf, 0x98, 0xf4, 0xb9, 0x21, 0x5d, 0xb9, 0x37, 0xe9, 0xf3, 0xdb, 0x48, 0x9f, 0xcf, 0x92, 0x6a,
-	0x1f, 0x59, 0xec, 0x73, 0x99, 0x68, 0xd9, 0xf7, 0x2f, 0xf6, 0x1b, 0x49, 0xad, 0x8d, 0x35, 0x9a,
-	0xee, 0x4f, 0xa0, 0x19, 0xd0, 0x8c, 0x0b, 0xa9, 0xcb, 0x68, 0x2f, 0x21, 0x86, 0xb3, 0xac, 0x38,
-	0x8f, 0xef, 0xc4, 0xf9, 0xc4, 0x7c, 0xad, 0x6e, 0xc1, 0xf3, 0x50, 0x63, 0x56, 0xad, 0xd9, 0x7b,
-	0xc0, 0xe9, 0x11, 0x41, 0x18, 0xef, 0xe4, 0x2c, 0x32, 0xcc, 0x40, 0x31, 0x1f, 0xdd, 0x89, 0xd9,
-	0x9c, 0x83, 0x79, 0x2c, 0x0f, 0xd5, 0x27, 0x2a, 0xcd, 0xf8, 0x0e, 0xd4, 0x62, 0xb9, 0x8c, 0x4e,
-	0x9e, 0x18, 0xbe, 0x8a, 0xe2, 0x3b, 0xbc, 0x13, 0x9f, 0x39, 0xcc, 0xb3, 0x48, 0x1e, 0x5a, 0x1d,
-	0x29, 0x34, 0x57, 0x0e, 0x60, 0x9a, 0xc7, 0xcc, 0x8f, 0x12, 0x1c, 0xc4, 0x84, 0x19, 0xbe, 0xaa,
-	0xe2, 0xfb, 0xe2, 0x4e, 0x7c, 0x8f, 0x34, 0xdf, 0x4d, 0x34, 0x0f, 0x39, 0x52, 0xf9, 0x85, 0xd6,
-	0x69, 0xda, 0x10, 0x54, 0x3b, 0x84, 0x25, 0x71, 0x66, 0x08, 0x57, 0x15, 0xe1, 0xc1, 0x9d, 0x08,
-	0x4d, 0x9d, 0x4e, 0xe3, 0x78, 0xa8, 0xa2, 0xc5, 0x31, 0x4b, 0x42, 0xb3, 0x90, 0x8e, 0x58, 0xd6,
-	0xee, 0xcf, 0x32, 0x8d, 0xe3, 0xa1, 0x8a, 0x16, 0x35, 0xcb, 0x00, 0x34, 0x30, 0x63, 0xf4, 0xfd,
-	0x5c, 0x0e, 0xa1, 0x22, 0xfb, 0xf2, 0x4e, 0x64, 0x8f, 0x35, 0xd9, 0x2d, 0x70, 0x1e, 0x5a, 0x53,
-	0xda, 0x99, 0x2c, 0xe6, 0x00, 0x46, 0x0c, 0x0f, 0xe7, 0x88, 0x9b, 0xf7, 0xdf, 0xbc, 0x9b, 0x68,
-	0x1e, 0x72, 0xa4, 0x72, 0x86, 0xf6, 0x8f, 0xa0, 0x99, 0x12, 0x16, 0x11, 0x3f, 0x23, 0x82, 0xf7,
-	0x92, 0x58, 0x18, 0xe2, 0x87, 0xf7, 0x3f, 0x8f, 0xb7, 0xe1, 0x79, 0x08, 0x2a, 0xf5, 0xa9, 0xd1,
-	0x8e, 0x0f, 0x07, 0xef, 0xe2, 0x2c, 0xea, 0xe2, 0xd8, 0xd0, 0xae, 0xdf, 0xff, 0x70, 0xcc, 0x22,
-	0x79, 0x68, 0x75, 0xa4, 0x18, 0xd7, 0x4f, 0x80, 0xb3, 0x20, 0x1f, 0xd5, 0xcf, 0x47, 0xf7, 0xaf,
-	0x9f, 0x69, 0x1c, 0x79, 0x3d, 0x52, 0xa2, 0x62, 0x39, 0xb1, 0xec, 0x9a, 0x53, 0x3f, 0xb1, 0xec,
-	0xba, 0xe3, 0x9c, 0x58, 0xb6, 0xe3, 0xac, 0x9d, 0x58, 0x76, 0xc3, 0x69, 0xa2, 0xd5, 0x21, 0x4d,
-	0xa8, 0xdf, 0xff, 0x54, 0x4f, 0x42, 0x15, 0xf2, 0x1e, 0x73, 0xf3, 0x8d, 0x44, 0xb5, 0x00, 0x0b,
-	0x9c, 0x0c, 0xb9, 0x49, 0x15, 0x72, 0x74, 0x02, 0xa7, 0xba, 0xf6, 0x0e, 0x58, 0x7a, 0x23, 0xe4,
-	0xc5, 0xd2, 0x01, 0xa5, 0x0b, 0x32, 0xd4, 0xb7, 0x11, 0x24, 0x87, 0xb0, 0x09, 0x96, 0xfa, 0x38,
-	0xc9, 0xf5, 0x0d, 0xb5, 0x8c, 0xb4, 0xe0, 0x9d, 0x81, 0xfa, 0x39, 0xc3, 0x19, 0xc7, 0x81, 0x88,
-	0x69, 0xf6, 0x8a, 0x46, 0x1c, 0x42, 0x60, 0xa9, 0xae, 0xa8, 0xe7, 0xaa, 0x31, 0xfc, 0x29, 0xb0,
-	0x12, 0x1a, 0xf1, 0xd6, 0xa2, 0xba, 0xbd, 0x3d, 0xbc, 0x79, 0x7b, 0x7b, 0x45, 0x23, 0xa4, 0x5c,
-	0xbc, 0x7f, 0x2c, 0x82, 0xd2, 0x2b, 0x1a, 0xc1, 0x16, 0x58, 0xc1, 0x61, 0xc8, 0x08, 0xe7, 0x06,
-	0x69, 0x24, 0xc2, 0x75, 0xb0, 0x2c, 0x68, 0x2f, 0x0e, 0x34, 0x5c, 0x19, 0x19, 0x49, 0x12, 0x87,
-	0x58, 0x60, 0x75, 0xaf, 0xa8, 0x22, 0x35, 0x86, 0x7b, 0xa0, 0xaa, 0x22, 0xf3, 0xb3, 0x3c, 0xed,
-	0x10, 0xa6, 0xae, 0x07, 0x56, 0xbb, 0x7e, 0x55, 0xb8, 0x15, 0xa5, 0x3f, 0x55, 0x6a, 0x34, 0x2d,
-	0xc0, 0x8f, 0xc1, 0x8a, 0x18, 0x4c, 0x77, 0xf6, 0xc6, 0x55, 0xe1, 0xd6, 0xc5, 0x24, 0x4c, 0xd9,
-	0xb8, 0xd1, 0xb2, 0x18, 0xa8, 0x06, 0xbe, 0x03, 0x6c, 0x31, 0xf0, 0xe3, 0x2c, 0x24, 0x03, 0xd5,
-	0xbc, 0xad, 0x76, 0xf3, 0xaa, 0x70, 0x9d, 0x29, 0xf7, 0x63, 0x69, 0x43, 0x2b, 0x62, 0xa0, 0x06,
-	0xf0, 0x63, 0x00, 0xf4, 0x92, 0x14, 0x83, 0x6e, 0xbd, 0xab, 0x57, 0x85, 0x5b, 0x56, 0x5a, 0x85,
-	0x3d, 0x19, 0x42, 0x0f, 0x2c, 0x69, 0x6c, 0x5b, 0x61, 0x57, 0xaf, 0x0a, 0xd7, 0x4e, 0x68, 0xa4,
-	0x31, 0xb5, 0x49, 0xa6, 0x8a, 0x91, 0x94, 0xf6, 0x49, 0xa8, 0xba, 0x9b, 0x8d, 0x46, 0xa2, 0xf7,
-	0x97, 0x45, 0x60, 0x9f, 0x0f, 0x10, 0xe1, 0x79, 0x22, 0xe0, 0xe7, 0xc0, 0x09, 0x68, 0x26, 0x18,
-	0x0e, 0x84, 0x3f, 0x93, 0xda, 0xf6, 0x93, 0x49, 0xa7, 0x99, 0xf7, 0xf0, 0x50, 0x7d, 0xa4, 0x3a,
-	0x30, 0xf9, 0x6f, 0x82, 0xa5, 0x4e, 0x42, 0x69, 0xaa, 0x2a, 0xa1, 0x8a, 0xb4, 0x00, 0x91, 0xca,
-	0x9a, 0xda, 0xe5, 0x92, 0x7a, 0x09, 0xfc, 0xf0, 0xe6, 0x2e, 0xcf, 0x95, 0x4a, 0x7b, 0xdd, 0xbc,
-	0x06, 0x6a, 0x9a, 0xdb, 0xcc, 0xf7, 0x64, 0x6e, 0x55, 0x29, 0x39, 0xa0, 0xc4, 0x88, 0x50, 0x9b,
-	0x56, 0x45, 0x72, 0x08, 0x1f, 0x03, 0x9b, 0x91, 0x3e, 0x61, 0x82, 0x84, 0x6a, 0x73, 0x6c, 0x34,
-	0x96, 0xe1, 0x23, 0x60, 0x47, 0x98, 0xfb, 0x39, 0x27, 0xa1, 0xde, 0x09, 0xb4, 0x12, 0x61, 0xfe,
-	0x96, 0x93, 0xf0, 0x33, 0xeb, 0xcf, 0x5f, 0xbb, 0x0f, 0x3c, 0x0c, 0x2a, 0x07, 0x41, 0x40, 0x38,
-	0x3f, 0xcf, 0x7b, 0x09, 0xf9, 0x1f, 0x15, 0xb6, 0x07, 0xaa, 0x5c, 0x50, 0x86, 0x23, 0xe2, 0x5f,
-	0x90, 0xa1, 0xa9, 0x33, 0x5d, 0x35, 0x46, 0xff, 0x2b, 0x32, 0xe4, 0x68, 0x5a, 0x30, 0x14, 0x5f,
-	0x5b, 0xa0, 0x72, 0xce, 0x70, 0x40, 0xcc, 0x0d, 0x5f, 0xd6, 0xaa, 0x14, 0x99, 0xa1, 0x30, 0x92,
-	0xe4, 0x16, 0x71, 0x4a, 0x68, 0x2e, 0xcc, 0x79, 0x1a, 0x89, 0x72, 0x06, 0x23, 0x64, 0x40, 0x02,
-	0x95, 0x46, 0x0b, 0x19, 0x09, 0xee, 0x83, 0xd5, 0x30, 0xe6, 0xea, 0x39, 0xc7, 0x05, 0x0e, 0x2e,
-	0x74, 0xf8, 0x6d, 0xe7, 0xaa, 0x70, 0xab, 0xc6, 0xf0, 0x46, 0xea, 0xd1, 0x8c, 0x04, 0x5f, 0x80,
-	0xfa, 0x64, 0x9a, 0x5a, 0xad, 0xca, 0x8d, 0xdd, 0x86, 0x57, 0x85, 0x5b, 0x1b, 0xbb, 0x2a, 0x0b,
-	0x9a, 0x93, 0xe5, 0x4e, 0x87, 0xa4, 0x93, 0x47, 0xaa, 0xf8, 0x6c, 0xa4, 0x05, 0xa9, 0x4d, 0xe2,
-	0x34, 0x16, 0xaa, 0xd8, 0x96, 0x90, 0x16, 0xe0, 0x0b, 0x50, 0xa6, 0x7d, 0xc2, 0x58, 0x1c, 0x12,
-	0xae, 0xae, 0x3a, 0xff, 0xef, 0x2d, 0x88, 0x26, 0xfe, 0x32, 0x38, 0xf3, 0x54, 0x4d, 0x49, 0x4a,
-	0xd9, 0x50, 0xdd, 0x5d, 0x4c, 0x70, 0xda, 0xf0, 0x5a, 0xe9, 0xd1, 0x8c, 0x04, 0xdb, 0xc0, 0xbc,
-	0xb2, 0x7c, 0x46, 0x44, 0xce, 0x32, 0x5f, 0x9d, 0xff, 0xaa, 0x9a, 0xab, 0x4e, 0xa1, 0xb6, 0x22,
-	0x65, 0x7c, 0x89, 0x05, 0x46, 0x37, 0x34, 0xf0, 0xe7, 0x00, 0xea, 0x3d, 0xf1, 0xdf, 0x71, 0x3a,
-	0x7e, 0xcc, 0xea, 0xab, 0x85, 0xe2, 0xd7, 0x56, 0xb3, 0x66, 0x47, 0x4b, 0x27, 0x9c, 0x9a, 0x28,
-	0x4e, 0x2c, 0xdb, 0x72, 0x96, 0x4e, 0x2c, 0x7b, 0xc5, 0xb1, 0xc7, 0xf9, 0x33, 0x51, 0xa0, 0xc6,
-	0x48, 0x9e, 0x5a, 0x9e, 0xf7, 0x9f, 0x05, 0xe0, 0xcc, 0x3f, 0x49, 0xe1, 0x26, 0xa8, 0xa6, 0x3c,
-	0xf2, 0x65, 0x0f, 0xf0, 0x73, 0x96, 0x98, 0x6a, 0x01, 0x29, 0x8f, 0xce, 0x87, 0x3d, 0xf2, 0x96,
-	0x25, 0xf0, 0x29, 0x68, 0x48, 0x0f, 0xf5, 0xd9, 0xd5, 0x7e, 0x19, 0x4e, 0x47, 0x5f, 0x63, 0x27,
-	0xe5, 0xd1, 0x6f, 0xa4, 0x45, 0x7a, 0x9f, 0xe2, 0x94, 0xc0, 0x13, 0x50, 0x99, 0xb8, 0xca, 0x23,
-	0x29, 0x3f, 0xbc, 0x3f, 0xfa, 0xae, 0x67, 0xf3, 0x6b, 0x1e, 0x1d, 0x08, 0xc1, 0xe4, 0xec, 0xb6,
-	0x25, 0x0f, 0x25, 0x02, 0xfd, 0x11, 0x1c, 0x87, 0xa7, 0xa0, 0x9a, 0xc9, 0x77, 0x64, 0x68, 0xc0,
-	0x2c, 0x05, 0xf6, 0xe3, 0xef, 0x02, 0x3b, 0x55, 0xbe, 0xaf, 0xf5, 0xd2, 0x0d, 0x5c, 0x45, 0x03,
-	0x28, 0x3c, 0xef, 0x1d, 0x68, 0xdc, 0xe2, 0x29, 0xbf, 0xdf, 0x2a, 0x24, 0xd3, 0x38, 0xe4, 0x18,
-	0xfe, 0x02, 0x2c, 0x61, 0x21, 0xd8, 0xa8, 0x73, 0xdc, 0x21, 0x00, 0x3d, 0xcf, 0x7b, 0x01, 0xd6,
-	0x6e, 0x78, 0xdc, 0xca, 0x04, 0x81, 0x25, 0xa3, 0x33, 0x09, 0x55, 0xe3, 0xf6, 0x2f, 0xbf, 0xb9,
-	0xdc, 0x58, 0xf8, 0xf6, 0x72, 0x63, 0xe1, 0xdf, 0x97, 0x1b, 0x0b, 0x7f, 0xfd, 0xb0, 0xf1, 0xe0,
-	0xdb, 0x0f, 0x1b, 0x0f, 0xfe, 0xf9, 0x61, 0xe3, 0xc1, 0xef, 0xa6, 0x5b, 0x39, 0xe9, 0xcb, 0x4e,
-	0x3e, 0xf9, 0x2b, 0x69, 0xa0, 0xfe, 0x4c, 0x52, 0xa9, 0xea, 0x2c, 0xab, 0x3f, 0x89, 0x3e, 0xfd,
-	0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe0, 0x37, 0x66, 0x64, 0x6a, 0x12, 0x00, 0x00,
+	// 1848 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0xdb, 0x4e, 0xe4, 0xc8,
+	0x19, 0xe6, 0x60, 0xc0, 0x5d, 0xdd, 0x34, 0xa6, 0xba, 0x87, 0xed, 0x61, 0x14, 0x4c, 0x1c, 0x25,
+	0x22, 0xd2, 0x0e, 0x2c, 0xac, 0xd0, 0x8c, 0x76, 0x94, 0x03, 0xcd, 0xb0, 0xbb, 0x90, 0x19, 0x82,
+	0x6a, 0x98, 0x44, 0x8a, 0x14, 0x59, 0xd5, 0x76, 0xad, 0xf1, 0x60, 0xbb, 0xac, 0xaa, 0x72, 0x4f,
+	0x77, 0x92, 0x07, 0x88, 0x94, 0x9b, 0x3c, 0x41, 0x94, 0xdb, 0xbc, 0x43, 0x1e, 0x60, 0x95, 0xab,
+	0xbd, 0x8c, 0x72, 0x61, 0x45, 0xcc, 0x1d, 0x97, 0xbc, 0x40, 0xa2, 0x3a, 0xf4, 0x11, 0x76, 0xb5,
+	0x70, 0xd5, 0xf5, 0x1f, 0xea, 0xfb, 0xea, 0xff, 0xeb, 0x2f, 0xff, 0x55, 0x0d, 0xd6, 0x89, 0xb8,
+	0x20, 0x2c, 0x8d, 0x33, 0xb1, 0x43, 0xba, 0xe9, 0x4e, 0x77, 0x57, 0xfe, 0x6c, 0xe7, 0x8c, 0x0a,
+	0x0a, 0x9d, 0xa1, 0x6d, 0x5b, 0x2a, 0xbb, 0xbb, 0xeb, 0xcd, 0x88, 0x46, 0x54, 0x19, 0x77, 0xe4,
+	0x48, 0xfb, 0x79, 0xff, 0xb0, 0xc0, 0xe2, 0x19, 0x66, 0x38, 0xe5, 0x70, 0x17, 0x54, 0x48, 0x37,
+	0xf5, 0x43, 0x92, 0xd1, 0xb4, 0x35, 0xbb, 0x39, 0xbb, 0x55, 0x69, 0x37, 0x6f, 0x4a, 0xd7, 0xe9,
+	0xe3, 0x34, 0xf9, 0xcc, 0x1b, 0x9a, 0x3c, 0x64, 0x93, 0x6e, 0xfa, 0x52, 0x0e, 0xe1, 0xcf, 0xc0,
+	0x32, 0xc9, 0x70, 0x27, 0x21, 0x7e, 0xc0, 0x08, 0x16, 0xa4, 0x35, 0xb7, 0x39, 0xbb, 0x65, 0xb7,
+	0x5b, 0x37, 0xa5, 0xdb, 0x34, 0xd3, 0xc6, 0xcd, 0x1e, 0xaa, 0x69, 0xf9, 0x50, 0x89, 0xf0, 0x19,
+	0xa8, 0x0e, 0xec, 0x38, 0x49, 0x5a, 0xf3, 0x6a, 0xf2, 0xda, 0x4d, 0xe9, 0xc2, 0xc9, 0xc9, 0x38,
+	0x49, 0x3c, 0x04, 0xcc, 0x54, 0x9c, 0x24, 0xf0, 0x00, 0x00, 0xd2, 0x13, 0x0c, 0xfb, 0x24, 0xce,
+	0x79, 0xcb, 0xda, 0x9c, 0xdf, 0x9a, 0x6f, 0x7b, 0x57, 0xa5, 0x5b, 0x39, 0x92, 0xda, 0xa3, 0xe3,
+	0x33, 0x7e, 0x53, 0xba, 0xab, 0x06, 0x64, 0xe8, 0xe8, 0xa1, 0x8a, 0x12, 0x8e, 0xe2, 0x9c, 0xc3,
+	0xdf, 0x83, 0x5a, 0x70, 0x81, 0xe3, 0xcc, 0x0f, 0x68, 0xf6, 0x55, 0x1c, 0xb5, 0x16, 0x36, 0x67,
+	0xb7, 0xaa, 0x7b, 0x3f, 0xd8, 0x9e, 0xce, 0xdb, 0xf6, 0xa1, 0xf4, 0x3a, 0x54, 0x4e, 0xed, 0x27,
+	0x5f, 0x97, 0xee, 0xcc, 0x4d, 0xe9, 0x36, 0x34, 0xf4, 0x38, 0x80, 0x87, 0xaa, 0xc1, 0xc8, 0x13,
+	0xa6, 0xa0, 0x41, 0xe2, 0xfc, 0xd9, 0xee, 0x9e, 0x8f, 0x93, 0x84, 0xbe, 0x27, 0xa1, 0x9f, 0xf2,
+	0x88, 0xb7, 0x16, 0x37, 0xe7, 0xb7, 0xaa, 0x7b, 0xde, 0x6d, 0x96, 0xa3, 0xe3, 0xb3, 0x67, 0xbb,
+	0x7b, 0x07, 0xda, 0xf7, 0x35, 0x8f, 0xda, 0x8f, 0x25, 0xd5, 0x55, 0xe9, 0xae, 0x4e, 0x5b, 0x38,
+	0x5a, 0xd5, 0xc8, 0x63, 0x2a, 0xb8, 0x07, 0x1e, 0x29, 0x1e, 0xbf, 0xc8, 0xe4, 0xbe, 0x92, 0x40,
+	0x90, 0xd0, 0x17, 0x3d, 0xde, 0x5a, 0x92, 0x39, 0x45, 0x0d, 0x65, 0x7c, 0x3b, 0xb2, 0x9d, 0xf7,
+	0x38, 0xdc, 0x01, 0x0d, 0x9d, 0xd2, 0xd0, 0xcf, 0x19, 0x09, 0x68, 0x9a, 0xc7, 0x09, 0xe1, 0x2d,
+	0x7b, 0x73, 0x7e, 0xab, 0x82, 0xa0, 0x31, 0x9d, 0x8d, 0x2c, 0xde, 0xdf, 0x56, 0x41, 0xf5, 0x70,
+	0x22, 0xc6, 0x95, 0x0b, 0x9a, 0x12, 0x2e, 0x08, 0x0e, 0xfd, 0x4e, 0x42, 0x83, 0x4b, 0x53, 0x36,
+	0x2f, 0xff, 0x53, 0xba, 0x3f, 0x89, 0x62, 0x71, 0x51, 0x74, 0xb6, 0x03, 0x9a, 0xee, 0x04, 0x94,
+	0xa7, 0x94, 0x9b, 0x9f, 0xa7, 0x3c, 0xbc, 0xdc, 0x11, 0xfd, 0x9c, 0xf0, 0xed, 0xe3, 0x4c, 0xdc,
+	0x94, 0xee, 0x9a, 0x4e, 0xe6, 0x14, 0x94, 0x87, 0xea, 0x43, 0x4d, 0x5b, 0x2a, 0x60, 0x1f, 0xd4,
+	0x43, 0x4c, 0xfd, 0xaf, 0x28, 0xbb, 0x34, 0x6c, 0x73, 0x8a, 0xed, 0xcd, 0xf7, 0x67, 0xbb, 0x2a,
+	0xdd, 0xda, 0xcb, 0x83, 0x5f, 0x7f, 0x4e, 0xd9, 0xa5, 0xc2, 0xbc, 0x29, 0xdd, 0x47, 0x9a, 0x7d,
+	0x12, 0xd9, 0x43, 0xb5, 0x10, 0xd3, 0xa1, 0x1b, 0xfc, 0x2d, 0x70, 0x86, 0x0e, 0xbc, 0xc8, 0x73,
+	0xca, 0x84, 0xa9, 0xd6, 0xa7, 0x57, 0xa5, 0x5b, 0x37, 0x90, 0x6f, 0xb4, 0xe5, 0xa6, 0x74, 0x3f,
+	0x9a, 0x02, 0x35, 0x73, 0x3c, 0x54, 0x37, 0xb0, 0xc6, 0x15, 0x72, 0x50, 0x23, 0x71, 0xbe, 0xbb,
+	0xff, 0x89, 0x89, 0xc8, 0x52, 0x11, 0x9d, 0xdd, 0x2b, 0xa2, 0xea, 0xd1, 0xf1, 0xd9, 0xee, 0xfe,
+	0x27, 0x83, 0x80, 0x4c, 0x6d, 0x8e, 0xc3, 0x7a, 0xa8, 0xaa, 0x45, 0x1d, 0xcd, 0x31, 0x30, 0xa2,
+	0x7f, 0x81, 0xf9, 0x85, 0xaa, 0xfc, 0x4a, 0x7b, 0xeb, 0xaa, 0x74, 0x81, 0x46, 0xfa, 0x12, 0xf3,
+	0x8b, 0xd1, 0xbe, 0x74, 0xfa, 0x7f, 0xc0, 0x99, 0x88, 0x8b, 0x74, 0x80, 0x05, 0xf4, 0x64, 0xe9,
+	0x35, 0x5c, 0xff, 0xbe, 0x59, 0xff, 0xe2, 0x83, 0xd7, 0xbf, 0x7f, 0xd7, 0xfa, 0xf7, 0x27, 0xd7,
+	0xaf, 0x7d, 0x86, 0xa4, 0xcf, 0x0d, 0xe9, 0xd2, 0x83, 0x49, 0x9f, 0xdf, 0x45, 0xfa, 0x7c, 0x92,
+	0x54, 0xfb, 0xc8, 0x62, 0x9f, 0xca, 0x44, 0xcb, 0x7e, 0x78, 0xb1, 0xdf, 0x4a, 0x6a, 0x7d, 0xa8,
+	0xd1, 0x74, 0x7f, 0x02, 0xcd, 0x80, 0x66, 0x5c, 0x48, 0x5d, 0x46, 0xf3, 0x84, 0x18, 0xce, 0x8a,
+	0xe2, 0x3c, 0xbe, 0x17, 0xe7, 0x13, 0xf3, 0xb5, 0xba, 0x03, 0xcf, 0x43, 0x8d, 0x49, 0xb5, 0x66,
+	0xcf, 0x81, 0x93, 0x13, 0x41, 0x18, 0xef, 0x14, 0x2c, 0x32, 0xcc, 0x40, 0x31, 0x1f, 0xdd, 0x8b,
+	0xd9, 0x9c, 0x83, 0x69, 0x2c, 0x0f, 0xad, 0x8c, 0x54, 0x9a, 0xf1, 0x1d, 0xa8, 0xc7, 0x72, 0x19,
+	0x9d, 0x22, 0x31, 0x7c, 0x55, 0xc5, 0x77, 0x78, 0x2f, 0x3e, 0x73, 0x98, 0x27, 0x91, 0x3c, 0xb4,
+	0x3c, 0x50, 0x68, 0xae, 0x02, 0xc0, 0xb4, 0x88, 0x99, 0x1f, 0x25, 0x38, 0x88, 0x09, 0x33, 0x7c,
+	0x35, 0xc5, 0xf7, 0xc5, 0xbd, 0xf8, 0x1e, 0x6b, 0xbe, 0xdb, 0x68, 0x1e, 0x72, 0xa4, 0xf2, 0x0b,
+	0xad, 0xd3, 0xb4, 0x21, 0xa8, 0x75, 0x08, 0x4b, 0xe2, 0xcc, 0x10, 0x2e, 0x2b, 0xc2, 0x83, 0x7b,
+	0x11, 0x9a, 0x3a, 0x1d, 0xc7, 0xf1, 0x50, 0x55, 0x8b, 0x43, 0x96, 0x84, 0x66, 0x21, 0x1d, 0xb0,
+	0xac, 0x3e, 0x9c, 0x65, 0x1c, 0xc7, 0x43, 0x55, 0x2d, 0x6a, 0x96, 0x1e, 0x68, 0x60, 0xc6, 0xe8,
+	0xfb, 0xa9, 0x1c, 0x42, 0x45, 0xf6, 0xe5, 0xbd, 0xc8, 0xd6, 0x35, 0xd9, 0x1d, 0x70, 0x1e, 0x5a,
+	0x55, 0xda, 0x89, 0x2c, 0x16, 0x00, 0x46, 0x0c, 0xf7, 0xa7, 0x88, 0x9b, 0x0f, 0xdf, 0xbc, 0xdb,
+	0x68, 0x1e, 0x72, 0xa4, 0x72, 0x82, 0xf6, 0x8f, 0xa0, 0x99, 0x12, 0x16, 0x11, 0x3f, 0x23, 0x82,
+	0xe7, 0x49, 0x2c, 0x0c, 0xf1, 0xa3, 0x87, 0x9f, 0xc7, 0xbb, 0xf0, 0x3c, 0x04, 0x95, 0xfa, 0xd4,
+	0x68, 0x87, 0x87, 0x83, 0x5f, 0xe0, 0x2c, 0xba, 0xc0, 0xb1, 0xa1, 0x5d, 0x7b, 0xf8, 0xe1, 0x98,
+	0x44, 0xf2, 0xd0, 0xf2, 0x40, 0x31, 0xac, 0x9f, 0x00, 0x67, 0x41, 0x31, 0xa8, 0x9f, 0x8f, 0x1e,
+	0x5e, 0x3f, 0xe3, 0x38, 0xf2, 0x7a, 0xa4, 0x44, 0xc5, 0x72, 0x62, 0xd9, 0x75, 0x67, 0xe5, 0xc4,
+	0xb2, 0x57, 0x1c, 0xe7, 0xc4, 0xb2, 0x1d, 0x67, 0xf5, 0xc4, 0xb2, 0x1b, 0x4e, 0x13, 0x2d, 0xf7,
+	0x69, 0x42, 0xfd, 0xee, 0xa7, 0x7a, 0x12, 0xaa, 0x92, 0xf7, 0x98, 0x9b, 0x6f, 0x24, 0xaa, 0x07,
+	0x58, 0xe0, 0xa4, 0xcf, 0x4d, 0xaa, 0x90, 0xa3, 0x13, 0x38, 0xd6, 0xb5, 0x77, 0xc0, 0xc2, 0x1b,
+	0x21, 0x2f, 0x96, 0x0e, 0x98, 0xbf, 0x24, 0x7d, 0x7d, 0x1b, 0x41, 0x72, 0x08, 0x9b, 0x60, 0xa1,
+	0x8b, 0x93, 0x42, 0xdf, 0x50, 0x2b, 0x48, 0x0b, 0xde, 0x19, 0x58, 0x39, 0x67, 0x38, 0xe3, 0x38,
+	0x10, 0x31, 0xcd, 0x5e, 0xd1, 0x88, 0x43, 0x08, 0x2c, 0xd5, 0x15, 0xf5, 0x5c, 0x35, 0x86, 0x3f,
+	0x05, 0x56, 0x42, 0x23, 0xde, 0x9a, 0x53, 0xb7, 0xb7, 0x47, 0xb7, 0x6f, 0x6f, 0xaf, 0x68, 0x84,
+	0x94, 0x8b, 0xf7, 0xaf, 0x39, 0x30, 0xff, 0x8a, 0x46, 0xb0, 0x05, 0x96, 0x70, 0x18, 0x32, 0xc2,
+	0xb9, 0x41, 0x1a, 0x88, 0x70, 0x0d, 0x2c, 0x0a, 0x9a, 0xc7, 0x81, 0x86, 0xab, 0x20, 0x23, 0x49,
+	0xe2, 0x10, 0x0b, 0xac, 0xee, 0x15, 0x35, 0xa4, 0xc6, 0x70, 0x0f, 0xd4, 0x54, 0x64, 0x7e, 0x56,
+	0xa4, 0x1d, 0xc2, 0xd4, 0xf5, 0xc0, 0x6a, 0xaf, 0x5c, 0x97, 0x6e, 0x55, 0xe9, 0x4f, 0x95, 0x1a,
+	0x8d, 0x0b, 0xf0, 0x63, 0xb0, 0x24, 0x7a, 0xe3, 0x9d, 0xbd, 0x71, 0x5d, 0xba, 0x2b, 0x62, 0x14,
+	0xa6, 0x6c, 0xdc, 0x68, 0x51, 0xf4, 0xe4, 0x2f, 0xdc, 0x01, 0xb6, 0xe8, 0xf9, 0x71, 0x16, 0x92,
+	0x9e, 0x6a, 0xde, 0x56, 0xbb, 0x79, 0x5d, 0xba, 0xce, 0x98, 0xfb, 0xb1, 0xb4, 0xa1, 0x25, 0xd1,
+	0x53, 0x03, 0xf8, 0x31, 0x00, 0x7a, 0x49, 0x8a, 0x41, 0xb7, 0xde, 0xe5, 0xeb, 0xd2, 0xad, 0x28,
+	0xad, 0xc2, 0x1e, 0x0d, 0xa1, 0x07, 0x16, 0x34, 0xb6, 0xad, 0xb0, 0x6b, 0xd7, 0xa5, 0x6b, 0x27,
+	0x34, 0xd2, 0x98, 0xda, 0x24, 0x53, 0xc5, 0x48, 0x4a, 0xbb, 0x24, 0x54, 0xdd, 0xcd, 0x46, 0x03,
+	0xd1, 0xfb, 0xcb, 0x1c, 0xb0, 0xcf, 0x7b, 0x88, 0xf0, 0x22, 0x11, 0xf0, 0x73, 0xe0, 0x04, 0x34,
+	0x13, 0x0c, 0x07, 0xc2, 0x9f, 0x48, 0x6d, 0xfb, 0xc9, 0xa8, 0xd3, 0x4c, 0x7b, 0x78, 0x68, 0x65,
+	0xa0, 0x3a, 0x30, 0xf9, 0x6f, 0x82, 0x85, 0x4e, 0x42, 0x69, 0xaa, 0x2a, 0xa1, 0x86, 0xb4, 0x00,
+	0x91, 0xca, 0x9a, 0xda, 0xe5, 0x79, 0xf5, 0x12, 0xf8, 0xe1, 0xed, 0x5d, 0x9e, 0x2a, 0x95, 0xf6,
+	0x9a, 0x79, 0x0d, 0xd4, 0x35, 0xb7, 0x99, 0xef, 0xc9, 0xdc, 0xaa, 0x52, 0x72, 0xc0, 0x3c, 0x23,
+	0x42, 0x6d, 0x5a, 0x0d, 0xc9, 0x21, 0x5c, 0x07, 0x36, 0x23, 0x5d, 0xc2, 0x04, 0x09, 0xd5, 0xe6,
+	0xd8, 0x68, 0x28, 0xc3, 0xc7, 0xc0, 0x8e, 0x30, 0xf7, 0x0b, 0x4e, 0x42, 0xbd, 0x13, 0x68, 0x29,
+	0xc2, 0xfc, 0x2d, 0x27, 0xe1, 0x67, 0xd6, 0x9f, 0xff, 0xee, 0xce, 0x78, 0x18, 0x54, 0x0f, 0x82,
+	0x80, 0x70, 0x7e, 0x5e, 0xe4, 0x09, 0xf9, 0x8e, 0x0a, 0xdb, 0x03, 0x35, 0x2e, 0x28, 0xc3, 0x11,
+	0xf1, 0x2f, 0x49, 0xdf, 0xd4, 0x99, 0xae, 0x1a, 0xa3, 0xff, 0x15, 0xe9, 0x73, 0x34, 0x2e, 0x18,
+	0x8a, 0x7f, 0x5a, 0xa0, 0x7a, 0xce, 0x70, 0x40, 0xcc, 0x0d, 0x5f, 0xd6, 0xaa, 0x14, 0x99, 0xa1,
+	0x30, 0x92, 0xe4, 0x16, 0x71, 0x4a, 0x68, 0x21, 0xcc, 0x79, 0x1a, 0x88, 0x72, 0x06, 0x23, 0xa4,
+	0x47, 0x02, 0x95, 0x46, 0x0b, 0x19, 0x09, 0xee, 0x83, 0xe5, 0x30, 0xe6, 0xea, 0x39, 0xc7, 0x05,
+	0x0e, 0x2e, 0x75, 0xf8, 0x6d, 0xe7, 0xba, 0x74, 0x6b, 0xc6, 0xf0, 0x46, 0xea, 0xd1, 0x84, 0x04,
+	0x5f, 0x80, 0x95, 0xd1, 0x34, 0xb5, 0x5a, 0x95, 0x1b, 0xbb, 0x0d, 0xaf, 0x4b, 0xb7, 0x3e, 0x74,
+	0x55, 0x16, 0x34, 0x25, 0xcb, 0x9d, 0x0e, 0x49, 0xa7, 0x88, 0x54, 0xf1, 0xd9, 0x48, 0x0b, 0x52,
+	0x9b, 0xc4, 0x69, 0x2c, 0x54, 0xb1, 0x2d, 0x20, 0x2d, 0xc0, 0x17, 0xa0, 0x42, 0xbb, 0x84, 0xb1,
+	0x38, 0x24, 0xbc, 0x05, 0xbe, 0xc7, 0x5b, 0x10, 0x8d, 0xfc, 0x65, 0x70, 0xe6, 0xa9, 0x9a, 0x92,
+	0x94, 0xb2, 0x7e, 0xab, 0x3a, 0x0a, 0x4e, 0x1b, 0x5e, 0x2b, 0x3d, 0x9a, 0x90, 0x60, 0x1b, 0x98,
+	0x57, 0x96, 0xcf, 0x88, 0x28, 0x58, 0xe6, 0xab, 0xf3, 0x5f, 0x53, 0x73, 0xd5, 0x29, 0xd4, 0x56,
+	0xa4, 0x8c, 0x2f, 0xb1, 0xc0, 0xe8, 0x96, 0x06, 0xfe, 0x1c, 0x40, 0xbd, 0x27, 0xfe, 0x3b, 0x4e,
+	0x87, 0x8f, 0x59, 0x7d, 0xb5, 0x50, 0xfc, 0xda, 0x6a, 0xd6, 0xec, 0x68, 0xe9, 0x84, 0xd3, 0xc1,
+	0x1b, 0x6e, 0x1d, 0xd8, 0x39, 0x66, 0x38, 0x49, 0x48, 0xd2, 0xaa, 0xeb, 0x8a, 0x1c, 0xc8, 0x27,
+	0x96, 0x6d, 0x39, 0x0b, 0x27, 0x96, 0xbd, 0xe4, 0xd8, 0xc3, 0xdc, 0x9a, 0x08, 0x51, 0x63, 0x20,
+	0x8f, 0x2d, 0xdd, 0xfb, 0xdf, 0x2c, 0x70, 0xa6, 0x9f, 0xab, 0x70, 0x13, 0xd4, 0x52, 0x1e, 0xf9,
+	0xb2, 0x3f, 0xf8, 0x05, 0x4b, 0x4c, 0x25, 0x81, 0x94, 0x47, 0xe7, 0xfd, 0x9c, 0xbc, 0x65, 0x09,
+	0x7c, 0x0a, 0x1a, 0xd2, 0x43, 0x7d, 0x92, 0xb5, 0x5f, 0x86, 0xd3, 0xc1, 0x97, 0xda, 0x49, 0x79,
+	0xf4, 0x1b, 0x69, 0x91, 0xde, 0xa7, 0x38, 0x25, 0xf0, 0x04, 0x54, 0x47, 0xae, 0xf2, 0xb8, 0xca,
+	0x8f, 0xf2, 0x8f, 0xbe, 0xed, 0x49, 0xfd, 0x9a, 0x47, 0x07, 0x42, 0x30, 0x39, 0xbb, 0x6d, 0xc9,
+	0x03, 0x8b, 0x40, 0x77, 0x00, 0xc7, 0xe1, 0x29, 0xa8, 0x65, 0x84, 0xab, 0xc7, 0xb2, 0x02, 0xb3,
+	0x14, 0xd8, 0x8f, 0xbf, 0x0d, 0xec, 0x54, 0xf9, 0xbe, 0xe6, 0xd1, 0x18, 0x5c, 0x55, 0x03, 0x28,
+	0x3c, 0xef, 0x1d, 0x68, 0xdc, 0xe1, 0x29, 0xbf, 0xed, 0x2a, 0x24, 0xd3, 0x54, 0xe4, 0x18, 0xfe,
+	0x02, 0x2c, 0x60, 0x21, 0xd8, 0xa0, 0xab, 0xdc, 0x23, 0x00, 0x3d, 0xcf, 0x7b, 0x01, 0x56, 0x6f,
+	0x79, 0xdc, 0xc9, 0x04, 0x81, 0x25, 0xa3, 0x33, 0x09, 0x55, 0xe3, 0xf6, 0x2f, 0xbf, 0xbe, 0xda,
+	0x98, 0xfd, 0xe6, 0x6a, 0x63, 0xf6, 0xbf, 0x57, 0x1b, 0xb3, 0x7f, 0xfd, 0xb0, 0x31, 0xf3, 0xcd,
+	0x87, 0x8d, 0x99, 0x7f, 0x7f, 0xd8, 0x98, 0xf9, 0xdd, 0x78, 0x9b, 0x27, 0x5d, 0xd9, 0xe5, 0x47,
+	0x7f, 0x33, 0xf5, 0xa4, 0x46, 0xb7, 0xfa, 0xce, 0xa2, 0xfa, 0x03, 0xe9, 0xd3, 0xff, 0x0f, 0x00,
+	0xa8, 0x6e, 0x93, 0xb8, 0x86, 0x12, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -1676,6 +1687,16 @@ func (m *TraceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Parallel {
+		i--
+		if m.Parallel {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
 	if len(m.TracerJsonConfig) > 0 {
 		i -= len(m.TracerJsonConfig)
 		copy(dAtA[i:], m.TracerJsonConfig)
@@ -2226,6 +2247,9 @@ func (m *TraceConfig) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvm(uint64(l))
 	}
+	if m.Parallel {
+		n += 2
+	}
 	return n
 }
 
@@ -4485,6 +4509,26 @@ func (m *TraceConfig) Unmarshal(dAtA []byte) error {
 			}
 			m.TracerJsonConfig = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parallel", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Parallel = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
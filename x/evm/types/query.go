@@ -30,6 +30,11 @@ func (m QueryTraceTxRequest) UnpackInterfaces(unpacker codectypes.AnyUnpacker) e
 }
 
 func (m QueryTraceBlockRequest) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, msg := range m.Predecessors {
+		if err := msg.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
 	for _, msg := range m.Txs {
 		if err := msg.UnpackInterfaces(unpacker); err != nil {
 			return err
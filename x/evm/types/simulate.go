@@ -0,0 +1,124 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// SimErrCodeVMError is the JSON-RPC error code of a simulated call that failed
+// with an EVM error other than a revert.
+const SimErrCodeVMError = -32015
+
+// MaxSimulateBlocks is the max number of blocks simulated by a query, same as geth.
+const MaxSimulateBlocks = 256
+
+// MaxSimulateCalls is the max number of calls simulated by a query across all its blocks.
+const MaxSimulateCalls = 1000
+
+// SimBlockTimeIncrement is the time in seconds between the simulated blocks whose time
+// is not overridden, same as geth.
+const SimBlockTimeIncrement = 12
+
+// SimErrCodeInvalidCall is the JSON-RPC error code of a simulated call that could
+// not be executed, eg. because of an invalid nonce or insufficient funds.
+const SimErrCodeInvalidCall = -32000
+
+// SimBlock is a batch of calls executed in order on top of the same block context.
+// It uses the same json format as the `blockStateCalls` elements of `eth_simulateV1`.
+type SimBlock struct {
+	BlockOverrides *BlockOverrides   `json:"blockOverrides,omitempty"`
+	StateOverrides *StateOverride    `json:"stateOverrides,omitempty"`
+	Calls          []TransactionArgs `json:"calls"`
+}
+
+// SimBlockResult is the result of a simulated block.
+type SimBlockResult struct {
+	Number    *hexutil.Big    `json:"number"`
+	Timestamp hexutil.Uint64  `json:"timestamp"`
+	GasLimit  hexutil.Uint64  `json:"gasLimit"`
+	GasUsed   hexutil.Uint64  `json:"gasUsed"`
+	Miner     common.Address  `json:"miner"`
+	BaseFee   *hexutil.Big    `json:"baseFeePerGas,omitempty"`
+	Calls     []SimCallResult `json:"calls"`
+}
+
+// SimCallResult is the result of a simulated call.
+type SimCallResult struct {
+	ReturnValue hexutil.Bytes   `json:"returnData"`
+	Logs        []*ethtypes.Log `json:"logs"`
+	GasUsed     hexutil.Uint64  `json:"gasUsed"`
+	Status      hexutil.Uint64  `json:"status"`
+	Error       *SimCallError   `json:"error,omitempty"`
+}
+
+// SimCallError is the error of a failed simulated call.
+type SimCallError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data,omitempty"`
+}
+
+// NewSimCallResult builds the result of a simulated call from the message response.
+func NewSimCallResult(rsp *MsgEthereumTxResponse, blockNumber uint64) SimCallResult {
+	logs := LogsToEthereum(rsp.Logs)
+	if logs == nil {
+		logs = []*ethtypes.Log{}
+	}
+	for _, log := range logs {
+		log.BlockNumber = blockNumber
+	}
+
+	result := SimCallResult{
+		ReturnValue: rsp.Ret,
+		Logs:        logs,
+		GasUsed:     hexutil.Uint64(rsp.GasUsed),
+		Status:      hexutil.Uint64(ethtypes.ReceiptStatusSuccessful),
+	}
+	if rsp.Failed() {
+		result.Status = hexutil.Uint64(ethtypes.ReceiptStatusFailed)
+		if rsp.VmError == vm.ErrExecutionReverted.Error() {
+			revertErr := NewExecErrorWithReason(rsp.Ret)
+			result.Error = &SimCallError{
+				Code:    revertErr.ErrorCode(),
+				Message: revertErr.Error(),
+				Data:    revertErr.ErrorData().(string),
+			}
+		} else {
+			result.Error = &SimCallError{
+				Code:    SimErrCodeVMError,
+				Message: rsp.VmError,
+			}
+		}
+	}
+	return result
+}
+
+// NewSimCallErrorResult builds the result of a simulated call that could not be executed.
+func NewSimCallErrorResult(err error) SimCallResult {
+	return SimCallResult{
+		ReturnValue: hexutil.Bytes{},
+		Logs:        []*ethtypes.Log{},
+		Status:      hexutil.Uint64(ethtypes.ReceiptStatusFailed),
+		Error: &SimCallError{
+			Code:    SimErrCodeInvalidCall,
+			Message: err.Error(),
+		},
+	}
+}
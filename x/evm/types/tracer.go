@@ -16,10 +16,14 @@
 package types
 
 import (
+	"encoding/json"
+	"fmt"
 	"math/big"
 	"os"
+	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum/eth/tracers"
 	"github.com/ethereum/go-ethereum/eth/tracers/logger"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -58,6 +62,44 @@ func NewTracer(tracer string, msg core.Message, cfg *params.ChainConfig, height
 	}
 }
 
+// TracerConstructor creates a native Go tracer for a trace request, cfg is the
+// tracer JSON config of the request, nil if it's not set.
+type TracerConstructor func(ctx *tracers.Context, cfg json.RawMessage) (tracers.Tracer, error)
+
+var (
+	tracerRegistryMtx sync.RWMutex
+	tracerRegistry    = map[string]TracerConstructor{}
+)
+
+// RegisterTracer registers a named native Go tracer, the registered tracers take
+// precedence over the go-ethereum ones of the same name when tracing. It's meant to be
+// called at app wiring time and panics if the name is empty or already registered.
+func RegisterTracer(name string, constructor TracerConstructor) {
+	if name == "" {
+		panic("tracer name cannot be empty")
+	}
+	if constructor == nil {
+		panic(fmt.Sprintf("tracer %s constructor cannot be nil", name))
+	}
+
+	tracerRegistryMtx.Lock()
+	defer tracerRegistryMtx.Unlock()
+
+	if _, found := tracerRegistry[name]; found {
+		panic(fmt.Sprintf("tracer %s already registered", name))
+	}
+	tracerRegistry[name] = constructor
+}
+
+// LookupTracer returns the constructor of the registered native Go tracer.
+func LookupTracer(name string) (TracerConstructor, bool) {
+	tracerRegistryMtx.RLock()
+	defer tracerRegistryMtx.RUnlock()
+
+	constructor, found := tracerRegistry[name]
+	return constructor, found
+}
+
 // TxTraceResult is the result of a single transaction trace during a block trace.
 type TxTraceResult struct {
 	Result interface{} `json:"result,omitempty"` // Trace results produced by the tracer
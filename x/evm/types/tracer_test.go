@@ -1,11 +1,31 @@
 package types
 
 import (
+	"encoding/json"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/eth/tracers"
 	"github.com/stretchr/testify/require"
 )
 
 func TestNewNoOpTracer(t *testing.T) {
 	require.Equal(t, &NoOpTracer{}, NewNoOpTracer())
 }
+
+func TestRegisterTracer(t *testing.T) {
+	constructor := func(_ *tracers.Context, _ json.RawMessage) (tracers.Tracer, error) {
+		return nil, nil
+	}
+
+	_, found := LookupTracer("testTracer")
+	require.False(t, found)
+
+	RegisterTracer("testTracer", constructor)
+	registered, found := LookupTracer("testTracer")
+	require.True(t, found)
+	require.NotNil(t, registered)
+
+	require.Panics(t, func() { RegisterTracer("testTracer", constructor) })
+	require.Panics(t, func() { RegisterTracer("", constructor) })
+	require.Panics(t, func() { RegisterTracer("nilTracer", nil) })
+}